@@ -0,0 +1,101 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ACCELTABLEENTRY flags.
+const (
+	_FVIRTKEY  uint16 = 0x01
+	_FSHIFT    uint16 = 0x04
+	_FCONTROL  uint16 = 0x08
+	_FALT      uint16 = 0x10
+	_ACCEL_END uint16 = 0x80
+)
+
+// Virtual key codes of the named keys, in lowercase.
+var _namedKeys = map[string]uint16{
+	"backspace": 0x08, "back": 0x08,
+	"tab":   0x09,
+	"enter": 0x0d, "return": 0x0d,
+	"pause": 0x13,
+	"esc":   0x1b, "escape": 0x1b,
+	"space":  0x20,
+	"pageup": 0x21, "pgup": 0x21,
+	"pagedown": 0x22, "pgdn": 0x22,
+	"end":    0x23,
+	"home":   0x24,
+	"left":   0x25,
+	"up":     0x26,
+	"right":  0x27,
+	"down":   0x28,
+	"insert": 0x2d, "ins": 0x2d,
+	"delete": 0x2e, "del": 0x2e,
+	"num0": 0x60, "num1": 0x61, "num2": 0x62, "num3": 0x63, "num4": 0x64,
+	"num5": 0x65, "num6": 0x66, "num7": 0x67, "num8": 0x68, "num9": 0x69,
+	"plus": 0xbb, "comma": 0xbc, "minus": 0xbd, "period": 0xbe,
+}
+
+// Encodes the accelerators into an RT_ACCELERATOR resource, an array of
+// ACCELTABLEENTRY; the last one is marked with 0x80.
+func encodeAccelTable(accels []_DescAccel) ([]byte, error) {
+	if len(accels) == 0 {
+		return nil, errors.New("no accelerators")
+	}
+
+	buf := make([]byte, 0, 8*len(accels))
+	for i, accel := range accels {
+		flags, vkey, err := parseAccelKey(accel.Key)
+		if err != nil {
+			return nil, err
+		}
+		if i == len(accels)-1 {
+			flags |= _ACCEL_END
+		}
+		buf = appendU16(buf, flags)
+		buf = appendU16(buf, vkey)
+		buf = appendU16(buf, accel.Cmd)
+		buf = appendU16(buf, 0) // padding
+	}
+	return buf, nil
+}
+
+// Parses a key combination like "Ctrl+Shift+S" or "Alt+F4" into the
+// ACCELTABLEENTRY flags and virtual key code.
+func parseAccelKey(key string) (flags, vkey uint16, err error) {
+	parts := strings.Split(key, "+")
+	if strings.HasSuffix(key, "++") { // "Ctrl++" means Ctrl and the plus key
+		parts = append(parts[:len(parts)-2], "plus")
+	}
+
+	flags = _FVIRTKEY
+	for _, mod := range parts[:len(parts)-1] {
+		switch strings.ToLower(strings.TrimSpace(mod)) {
+		case "ctrl", "control":
+			flags |= _FCONTROL
+		case "shift":
+			flags |= _FSHIFT
+		case "alt":
+			flags |= _FALT
+		default:
+			return 0, 0, fmt.Errorf("invalid modifier %q in key %q", mod, key)
+		}
+	}
+
+	name := strings.ToLower(strings.TrimSpace(parts[len(parts)-1]))
+	switch {
+	case len(name) == 1 && (name[0] >= 'a' && name[0] <= 'z' || name[0] >= '0' && name[0] <= '9'):
+		return flags, uint16(strings.ToUpper(name)[0]), nil // VK_A to VK_Z, VK_0 to VK_9
+	case len(name) >= 2 && name[0] == 'f':
+		if n, err := strconv.Atoi(name[1:]); err == nil && n >= 1 && n <= 24 {
+			return flags, 0x70 + uint16(n-1), nil // VK_F1 to VK_F24
+		}
+	}
+	if vkey, ok := _namedKeys[name]; ok {
+		return flags, vkey, nil
+	}
+	return 0, 0, fmt.Errorf("invalid key %q", key)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+func TestParseAccelKey(t *testing.T) {
+	tests := []struct {
+		key   string
+		flags uint16
+		vkey  uint16
+		fails bool
+	}{
+		{key: "Ctrl+O", flags: _FVIRTKEY | _FCONTROL, vkey: 'O'},
+		{key: "ctrl+shift+s", flags: _FVIRTKEY | _FCONTROL | _FSHIFT, vkey: 'S'},
+		{key: "Control + Alt + 5", flags: _FVIRTKEY | _FCONTROL | _FALT, vkey: '5'},
+		{key: "Alt+F4", flags: _FVIRTKEY | _FALT, vkey: 0x73},
+		{key: "F24", flags: _FVIRTKEY, vkey: 0x87},
+		{key: "Ctrl++", flags: _FVIRTKEY | _FCONTROL, vkey: 0xbb},
+		{key: "Shift+Del", flags: _FVIRTKEY | _FSHIFT, vkey: 0x2e},
+		{key: "Esc", flags: _FVIRTKEY, vkey: 0x1b},
+		{key: "Win+A", fails: true},
+		{key: "F25", fails: true},
+		{key: "F0", fails: true},
+		{key: "Ctrl+", fails: true},
+		{key: "Ctrl+Foo", fails: true},
+		{key: "", fails: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			flags, vkey, err := parseAccelKey(tt.key)
+			if tt.fails {
+				if err == nil {
+					t.Fatalf("expected error, got flags 0x%02x, vkey 0x%02x", flags, vkey)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if flags != tt.flags || vkey != tt.vkey {
+				t.Errorf("got flags 0x%02x, vkey 0x%02x; want 0x%02x, 0x%02x",
+					flags, vkey, tt.flags, tt.vkey)
+			}
+		})
+	}
+}
+
+func TestEncodeAccelTable(t *testing.T) {
+	data, err := encodeAccelTable([]_DescAccel{
+		{Key: "Ctrl+O", Cmd: 1001},
+		{Key: "F5", Cmd: 1002},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := testbuf.Words(
+		_FVIRTKEY|_FCONTROL, uint16('O'), uint16(1001), uint16(0),
+		_FVIRTKEY|_ACCEL_END, uint16(0x74), uint16(1002), uint16(0), // last entry is marked
+	)
+	if !bytes.Equal(data, want) {
+		t.Errorf("got % x\nwant % x", data, want)
+	}
+
+	if _, err := encodeAccelTable(nil); err == nil {
+		t.Error("expected error for empty table")
+	}
+	if _, err := encodeAccelTable([]_DescAccel{{Key: "Hyper+X", Cmd: 1}}); err == nil {
+		t.Error("expected error for invalid key")
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	_IMAGE_FILE_MACHINE_I386  = 0x014c
+	_IMAGE_FILE_MACHINE_AMD64 = 0x8664
+
+	_IMAGE_FILE_LINE_NUMS_STRIPPED = 0x0004
+	_IMAGE_FILE_32BIT_MACHINE      = 0x0100
+
+	_IMAGE_REL_I386_DIR32NB   = 0x0007
+	_IMAGE_REL_AMD64_ADDR32NB = 0x0003
+
+	_IMAGE_SCN_CNT_INITIALIZED_DATA = 0x0000_0040
+	_IMAGE_SCN_ALIGN_4BYTES         = 0x0030_0000
+	_IMAGE_SCN_MEM_READ             = 0x4000_0000
+	_IMAGE_SCN_MEM_WRITE            = 0x8000_0000
+
+	_IMAGE_SYM_CLASS_STATIC = 3
+)
+
+// Writes a COFF object file with a single .rsrc section, which the linker
+// merges into the resource directory of the executable. Each RVA offset in
+// relocs receives a relocation against the section symbol.
+func writeCoff(rsrc []byte, relocs []uint32, arch string) ([]byte, error) {
+	var machine, characteristics, relocType uint16
+	switch arch {
+	case "386":
+		machine = _IMAGE_FILE_MACHINE_I386
+		characteristics = _IMAGE_FILE_LINE_NUMS_STRIPPED | _IMAGE_FILE_32BIT_MACHINE
+		relocType = _IMAGE_REL_I386_DIR32NB
+	case "amd64":
+		machine = _IMAGE_FILE_MACHINE_AMD64
+		characteristics = _IMAGE_FILE_LINE_NUMS_STRIPPED
+		relocType = _IMAGE_REL_AMD64_ADDR32NB
+	default:
+		return nil, fmt.Errorf("unsupported architecture: %q", arch)
+	}
+
+	const fileHeaderSize, sectionHeaderSize, relocSize, symbolSize = 20, 40, 10, 18
+	rawOff := fileHeaderSize + sectionHeaderSize
+	relocsOff := rawOff + len(rsrc)
+	symbolsOff := relocsOff + relocSize*len(relocs)
+
+	buf := make([]byte, symbolsOff+symbolSize+4) // string table has only its size
+
+	hdr := buf[0:] // IMAGE_FILE_HEADER
+	binary.LittleEndian.PutUint16(hdr[0:], machine)
+	binary.LittleEndian.PutUint16(hdr[2:], 1) // NumberOfSections
+	binary.LittleEndian.PutUint32(hdr[8:], uint32(symbolsOff))
+	binary.LittleEndian.PutUint32(hdr[12:], 1) // NumberOfSymbols
+	binary.LittleEndian.PutUint16(hdr[18:], characteristics)
+
+	sec := buf[fileHeaderSize:] // IMAGE_SECTION_HEADER
+	copy(sec[0:8], ".rsrc")
+	binary.LittleEndian.PutUint32(sec[16:], uint32(len(rsrc))) // SizeOfRawData
+	binary.LittleEndian.PutUint32(sec[20:], uint32(rawOff))
+	binary.LittleEndian.PutUint32(sec[24:], uint32(relocsOff))
+	binary.LittleEndian.PutUint16(sec[32:], uint16(len(relocs)))
+	binary.LittleEndian.PutUint32(sec[36:], _IMAGE_SCN_CNT_INITIALIZED_DATA|
+		_IMAGE_SCN_ALIGN_4BYTES|_IMAGE_SCN_MEM_READ|_IMAGE_SCN_MEM_WRITE)
+
+	copy(buf[rawOff:], rsrc)
+
+	for i, rva := range relocs { // IMAGE_RELOCATION
+		rel := buf[relocsOff+relocSize*i:]
+		binary.LittleEndian.PutUint32(rel[0:], rva)
+		binary.LittleEndian.PutUint32(rel[4:], 0) // the section symbol
+		binary.LittleEndian.PutUint16(rel[8:], relocType)
+	}
+
+	sym := buf[symbolsOff:] // IMAGE_SYMBOL
+	copy(sym[0:8], ".rsrc")
+	binary.LittleEndian.PutUint16(sym[12:], 1) // SectionNumber, one-based
+	sym[16] = _IMAGE_SYM_CLASS_STATIC
+
+	binary.LittleEndian.PutUint32(buf[symbolsOff+symbolSize:], 4) // string table size
+	return buf, nil
+}
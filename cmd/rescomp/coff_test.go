@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"debug/pe"
+	"testing"
+)
+
+func TestWriteCoff(t *testing.T) {
+	tree := &_ResTree{}
+	tree.add(_RT_MANIFEST, 1, 0x0409, []byte("<assembly/>"))
+	tree.add(_RT_STRING, 1, 0x0409, encodeStringTables(map[uint16]string{0: "a"})[1])
+	tree.add(_RT_STRING, 1, 0x0416, encodeStringTables(map[uint16]string{0: "b"})[1])
+	if err := tree.checkDuplicates(); err != nil {
+		t.Fatal(err)
+	}
+	rsrc, relocs := tree.serialize()
+
+	tests := []struct {
+		arch            string
+		machine         uint16
+		characteristics uint16
+		relocType       uint16
+	}{
+		{"386", _IMAGE_FILE_MACHINE_I386, _IMAGE_FILE_LINE_NUMS_STRIPPED | _IMAGE_FILE_32BIT_MACHINE, _IMAGE_REL_I386_DIR32NB},
+		{"amd64", _IMAGE_FILE_MACHINE_AMD64, _IMAGE_FILE_LINE_NUMS_STRIPPED, _IMAGE_REL_AMD64_ADDR32NB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arch, func(t *testing.T) {
+			coff, err := writeCoff(rsrc, relocs, tt.arch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			obj, err := pe.NewFile(bytes.NewReader(coff))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer obj.Close()
+
+			if obj.Machine != tt.machine || obj.Characteristics != tt.characteristics {
+				t.Errorf("header: got machine 0x%04x, characteristics 0x%04x",
+					obj.Machine, obj.Characteristics)
+			}
+			if obj.OptionalHeader != nil {
+				t.Error("object file must have no optional header")
+			}
+
+			if len(obj.Sections) != 1 {
+				t.Fatalf("got %d sections, want 1", len(obj.Sections))
+			}
+			sec := obj.Sections[0]
+			if sec.Name != ".rsrc" {
+				t.Errorf("section name: got %q", sec.Name)
+			}
+			if data, _ := sec.Data(); !bytes.Equal(data, rsrc) {
+				t.Error("section data differs from the .rsrc contents")
+			}
+
+			if len(sec.Relocs) != len(relocs) {
+				t.Fatalf("got %d relocations, want %d", len(sec.Relocs), len(relocs))
+			}
+			for i, rel := range sec.Relocs {
+				if rel.VirtualAddress != relocs[i] || rel.SymbolTableIndex != 0 || rel.Type != tt.relocType {
+					t.Errorf("relocation %d: got %+v, want offset 0x%x", i, rel, relocs[i])
+				}
+			}
+
+			if len(obj.Symbols) != 1 {
+				t.Fatalf("got %d symbols, want 1", len(obj.Symbols))
+			}
+			sym := obj.Symbols[0]
+			if sym.Name != ".rsrc" || sym.SectionNumber != 1 || sym.StorageClass != _IMAGE_SYM_CLASS_STATIC {
+				t.Errorf("symbol: got %+v", sym)
+			}
+		})
+	}
+
+	if _, err := writeCoff(rsrc, relocs, "arm64"); err == nil {
+		t.Error("expected error for unsupported architecture")
+	}
+}
+
+func TestResTreeDuplicates(t *testing.T) {
+	tree := &_ResTree{}
+	tree.add(_RT_MENU, 200, 0x0409, []byte{1})
+	tree.add(_RT_MENU, 200, 0x0416, []byte{2})
+	if err := tree.checkDuplicates(); err != nil {
+		t.Fatal(err)
+	}
+
+	tree.add(_RT_MENU, 200, 0x0409, []byte{3})
+	if err := tree.checkDuplicates(); err == nil {
+		t.Error("expected error for duplicated resource")
+	}
+}
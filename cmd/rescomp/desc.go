@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Description of the resources, read from the JSON file.
+type _Desc struct {
+	Language     uint16            `json:"language"` // LANGID of all resources; defaults to 1033, US English.
+	Manifest     string            `json:"manifest"` // Path of the manifest file.
+	Icons        []_DescIcon       `json:"icons"`
+	Version      *_DescVersion     `json:"version"`
+	Strings      map[uint16]string `json:"strings"` // String table, keyed by string ID.
+	Menus        []_DescMenu       `json:"menus"`
+	Accelerators []_DescAccelTable `json:"accelerators"`
+	Dialogs      []_DescDialog     `json:"dialogs"`
+}
+
+type _DescIcon struct {
+	Id   _DescId `json:"id"`
+	File string  `json:"file"` // Path of the .ico file.
+}
+
+type _DescVersion struct {
+	FileVersion    string            `json:"fileVersion"`    // Like "1.2.3.4".
+	ProductVersion string            `json:"productVersion"` // Defaults to FileVersion.
+	FileType       string            `json:"fileType"`       // "app" or "dll"; defaults to "app".
+	Strings        map[string]string `json:"strings"`        // Like CompanyName and FileDescription.
+}
+
+type _DescMenu struct {
+	Id    _DescId         `json:"id"`
+	Items []_DescMenuItem `json:"items"`
+}
+
+type _DescMenuItem struct {
+	Text      string          `json:"text"`
+	Id        uint16          `json:"id"`
+	Separator bool            `json:"separator"`
+	Grayed    bool            `json:"grayed"`
+	Checked   bool            `json:"checked"`
+	Items     []_DescMenuItem `json:"items"` // If not empty, the item is a popup.
+}
+
+type _DescAccelTable struct {
+	Id   _DescId      `json:"id"`
+	Keys []_DescAccel `json:"keys"`
+}
+
+type _DescAccel struct {
+	Key string `json:"key"` // Like "Ctrl+Shift+S" or "F5".
+	Cmd uint16 `json:"cmd"`
+}
+
+type _DescDialog struct {
+	Id       _DescId        `json:"id"`
+	Style    string         `json:"style"`   // Like "WS_POPUP|WS_CAPTION|DS_MODALFRAME".
+	ExStyle  string         `json:"exStyle"` // Like "WS_EX_TOOLWINDOW".
+	X        int16          `json:"x"`
+	Y        int16          `json:"y"`
+	Cx       int16          `json:"cx"`
+	Cy       int16          `json:"cy"`
+	Title    string         `json:"title"`
+	Menu     _DescId        `json:"menu"`  // Menu resource ID, optional.
+	Class    string         `json:"class"` // Window class, optional.
+	Font     *_DescFont     `json:"font"`  // Defaults to 9 pt Segoe UI.
+	Controls []_DescControl `json:"controls"`
+}
+
+// Numeric ID of a resource. Named IDs, given as JSON strings, are rejected,
+// since they are not supported.
+type _DescId uint16
+
+func (id *_DescId) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return fmt.Errorf("named resource ID %s is not supported, use a number", data)
+	}
+	var n uint16
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("invalid resource ID %s, must be a number from 0 to 65535", data)
+	}
+	*id = _DescId(n)
+	return nil
+}
+
+type _DescFont struct {
+	Size    uint16 `json:"size"`
+	Face    string `json:"face"`
+	Weight  uint16 `json:"weight"`
+	Italic  bool   `json:"italic"`
+	Charset uint8  `json:"charset"`
+}
+
+type _DescControl struct {
+	Id      int32  `json:"id"`    // Use -1 for static controls which are never referenced.
+	Class   string `json:"class"` // Like "Button", "Edit" or "SysListView32".
+	Text    string `json:"text"`
+	Style   string `json:"style"` // WS_CHILD and WS_VISIBLE are always added.
+	ExStyle string `json:"exStyle"`
+	X       int16  `json:"x"`
+	Y       int16  `json:"y"`
+	Cx      int16  `json:"cx"`
+	Cy      int16  `json:"cy"`
+}
+
+// Reads and parses the JSON description file.
+func loadDesc(path string) (*_Desc, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := &_Desc{}
+	if err := json.Unmarshal(data, desc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if desc.Language == 0 {
+		desc.Language = 0x0409 // en-US
+	}
+	return desc, nil
+}
+
+// Encodes all the described resources into a resource tree. The file paths
+// are relative to baseDir.
+func (d *_Desc) compile(baseDir string) (*_ResTree, error) {
+	tree := &_ResTree{}
+	lang := d.Language
+
+	if d.Manifest != "" {
+		data, err := os.ReadFile(filepath.Join(baseDir, d.Manifest))
+		if err != nil {
+			return nil, err
+		}
+		tree.add(_RT_MANIFEST, 1, lang, data) // CREATEPROCESS_MANIFEST_RESOURCE_ID
+	}
+
+	nextImgId := uint16(1) // RT_ICON IDs are shared by all icons
+	for _, icon := range d.Icons {
+		data, err := os.ReadFile(filepath.Join(baseDir, icon.File))
+		if err != nil {
+			return nil, err
+		}
+		group, images, err := encodeIconGroup(data, nextImgId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", icon.File, err)
+		}
+		for _, img := range images {
+			tree.add(_RT_ICON, nextImgId, lang, img)
+			nextImgId++
+		}
+		tree.add(_RT_GROUP_ICON, uint16(icon.Id), lang, group)
+	}
+
+	if d.Version != nil {
+		data, err := encodeVersionInfo(d.Version, lang)
+		if err != nil {
+			return nil, err
+		}
+		tree.add(_RT_VERSION, 1, lang, data) // VS_VERSION_INFO
+	}
+
+	for blockId, data := range encodeStringTables(d.Strings) {
+		tree.add(_RT_STRING, blockId, lang, data)
+	}
+
+	for _, menu := range d.Menus {
+		tree.add(_RT_MENU, uint16(menu.Id), lang, encodeMenu(menu.Items))
+	}
+
+	for _, accel := range d.Accelerators {
+		data, err := encodeAccelTable(accel.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("accelerator table %d: %w", accel.Id, err)
+		}
+		tree.add(_RT_ACCELERATOR, uint16(accel.Id), lang, data)
+	}
+
+	for i := range d.Dialogs {
+		dlg := &d.Dialogs[i]
+		data, err := encodeDialog(dlg)
+		if err != nil {
+			return nil, fmt.Errorf("dialog %d: %w", dlg.Id, err)
+		}
+		tree.add(_RT_DIALOG, uint16(dlg.Id), lang, data)
+	}
+
+	if err := tree.checkDuplicates(); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// Returns the keys of the map, sorted.
+func sortedKeys[K ~string | ~uint16, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadDescIds(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr string // empty if no error is expected
+	}{
+		{"numeric", `{"icons": [{"id": 101, "file": "a.ico"}]}`, ""},
+		{"named icon", `{"icons": [{"id": "MAINICON", "file": "a.ico"}]}`, `named resource ID "MAINICON" is not supported`},
+		{"named menu", `{"menus": [{"id": "MAIN"}]}`, `named resource ID "MAIN" is not supported`},
+		{"named accelerators", `{"accelerators": [{"id": "KEYS"}]}`, `named resource ID "KEYS" is not supported`},
+		{"named dialog", `{"dialogs": [{"id": "ABOUT"}]}`, `named resource ID "ABOUT" is not supported`},
+		{"named dialog menu", `{"dialogs": [{"id": 1, "menu": "MAIN"}]}`, `named resource ID "MAIN" is not supported`},
+		{"out of range", `{"menus": [{"id": 70000}]}`, "invalid resource ID 70000"},
+		{"negative", `{"menus": [{"id": -1}]}`, "invalid resource ID -1"},
+	}
+
+	dir := t.TempDir()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "res.json")
+			if err := os.WriteFile(path, []byte(tt.json), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			desc, err := loadDesc(path)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if desc.Language != 0x0409 {
+					t.Errorf("default language: got 0x%04x", desc.Language)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+const (
+	_DS_SETFONT  uint32 = 0x0040
+	_WS_CHILD    uint32 = 0x4000_0000
+	_WS_VISIBLE  uint32 = 0x1000_0000
+	_DEF_DLG_STY        = "WS_POPUP|WS_CAPTION|WS_SYSMENU|DS_MODALFRAME"
+)
+
+// Encodes the dialog into an RT_DIALOG resource, in the DLGTEMPLATEEX format,
+// followed by the DLGITEMTEMPLATEEX of each control.
+func encodeDialog(dlg *_DescDialog) ([]byte, error) {
+	styleStr := dlg.Style
+	if styleStr == "" {
+		styleStr = _DEF_DLG_STY
+	}
+	style, err := parseStyle(styleStr)
+	if err != nil {
+		return nil, err
+	}
+	exStyle, err := parseStyle(dlg.ExStyle)
+	if err != nil {
+		return nil, err
+	}
+
+	font := _DescFont{Size: 9, Face: "Segoe UI"}
+	if dlg.Font != nil {
+		font = *dlg.Font
+	}
+	style |= _DS_SETFONT
+
+	buf := make([]byte, 0, 256)  // arbitrary
+	buf = appendU16(buf, 1)      // dlgVer
+	buf = appendU16(buf, 0xffff) // signature
+	buf = appendU32(buf, 0)      // helpID
+	buf = appendU32(buf, exStyle)
+	buf = appendU32(buf, style)
+	buf = appendU16(buf, uint16(len(dlg.Controls)))
+	buf = appendCoords(buf, dlg.X, dlg.Y, dlg.Cx, dlg.Cy)
+
+	if dlg.Menu != 0 {
+		buf = appendU16(buf, 0xffff) // ordinal follows
+		buf = appendU16(buf, uint16(dlg.Menu))
+	} else {
+		buf = appendU16(buf, 0)
+	}
+	if dlg.Class != "" {
+		buf = appendSz(buf, dlg.Class)
+	} else {
+		buf = appendU16(buf, 0) // predefined dialog class
+	}
+	buf = appendSz(buf, dlg.Title)
+
+	buf = appendU16(buf, font.Size)
+	buf = appendU16(buf, font.Weight)
+	buf = append(buf, boolToByte(font.Italic), font.Charset)
+	buf = appendSz(buf, font.Face)
+
+	for i := range dlg.Controls {
+		ctrl := &dlg.Controls[i]
+		style, err := parseStyle(ctrl.Style)
+		if err != nil {
+			return nil, err
+		}
+		exStyle, err := parseStyle(ctrl.ExStyle)
+		if err != nil {
+			return nil, err
+		}
+
+		buf = appendPad(buf, 4) // each control is DWORD-aligned
+		buf = appendU32(buf, 0) // helpID
+		buf = appendU32(buf, exStyle)
+		buf = appendU32(buf, style|_WS_CHILD|_WS_VISIBLE)
+		buf = appendCoords(buf, ctrl.X, ctrl.Y, ctrl.Cx, ctrl.Cy)
+		buf = appendU32(buf, uint32(ctrl.Id))
+		buf = appendSz(buf, ctrl.Class)
+		buf = appendSz(buf, ctrl.Text)
+		buf = appendU16(buf, 0) // no creation data
+	}
+	return buf, nil
+}
+
+func appendCoords(buf []byte, x, y, cx, cy int16) []byte {
+	for _, v := range [...]int16{x, y, cx, cy} {
+		buf = appendU16(buf, uint16(v))
+	}
+	return buf
+}
+
+func boolToByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/rodrigocfd/windigo/peres"
+)
+
+func TestEncodeDialog(t *testing.T) {
+	data, err := encodeDialog(&_DescDialog{
+		Style: "WS_POPUP|WS_CAPTION|DS_CENTER",
+		X:     -5, Y: 10, Cx: 180, Cy: 60,
+		Title: "About",
+		Menu:  200,
+		Class: "MyDlgClass",
+		Font:  &_DescFont{Size: 10, Face: "Tahoma", Weight: 700, Italic: true, Charset: 1},
+		Controls: []_DescControl{
+			{Id: -1, Class: "Static", Text: "A", X: 1, Y: 2, Cx: 3, Cy: 4},
+			{Id: 1, Class: "Button", Text: "OK", Style: "WS_TABSTOP|BS_DEFPUSHBUTTON",
+				ExStyle: "0x200", X: 65, Y: 38, Cx: 50, Cy: 14},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dlg, err := peres.ParseDialog(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const wsPopup, wsCaption, dsCenter, wsTabStop = 0x8000_0000, 0x00c0_0000, 0x0800, 0x0001_0000
+	checks := []struct {
+		name      string
+		got, want any
+	}{
+		{"Extended", dlg.Extended, true},
+		{"Style", dlg.Style, uint32(wsPopup | wsCaption | dsCenter | _DS_SETFONT)},
+		{"coords", [4]int16{dlg.X, dlg.Y, dlg.Cx, dlg.Cy}, [4]int16{-5, 10, 180, 60}},
+		{"Title", dlg.Title, "About"},
+		{"Menu", dlg.Menu, peres.ResIdInt(200)},
+		{"Class", dlg.Class, peres.ResIdStr("MyDlgClass")},
+		{"font", [4]any{dlg.PointSize, dlg.Weight, dlg.Italic, dlg.CharSet}, [4]any{uint16(10), uint16(700), true, uint8(1)}},
+		{"FaceName", dlg.FaceName, "Tahoma"},
+		{"len(Controls)", len(dlg.Controls), 2},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	if t.Failed() {
+		return
+	}
+
+	static, button := dlg.Controls[0], dlg.Controls[1]
+	ctrlChecks := []struct {
+		name      string
+		got, want any
+	}{
+		{"static Id", static.Id, uint32(0xffff_ffff)},
+		{"static Style", static.Style, _WS_CHILD | _WS_VISIBLE},
+		{"static coords", [4]int16{static.X, static.Y, static.Cx, static.Cy}, [4]int16{1, 2, 3, 4}},
+		{"static Class", static.Class, peres.ResIdStr("Static")},
+		{"static Title", static.Title, peres.ResIdStr("A")},
+		{"button Id", button.Id, uint32(1)},
+		{"button Style", button.Style, _WS_CHILD | _WS_VISIBLE | wsTabStop | 0x1},
+		{"button ExStyle", button.ExStyle, uint32(0x200)},
+		{"button Class", button.Class, peres.ResIdStr("Button")},
+		{"button Title", button.Title, peres.ResIdStr("OK")},
+	}
+	for _, c := range ctrlChecks {
+		if c.got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestEncodeDialogDefaults(t *testing.T) {
+	data, err := encodeDialog(&_DescDialog{Title: "Empty"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dlg, err := peres.ParseDialog(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defStyle, _ := parseStyle(_DEF_DLG_STY)
+	if dlg.Style != defStyle|_DS_SETFONT {
+		t.Errorf("Style: got 0x%08x, want 0x%08x", dlg.Style, defStyle|_DS_SETFONT)
+	}
+	if dlg.Menu != (peres.ResId{}) || dlg.Class != (peres.ResId{}) {
+		t.Errorf("Menu and Class: got %v and %v, want none", dlg.Menu, dlg.Class)
+	}
+	if dlg.PointSize != 9 || dlg.FaceName != "Segoe UI" {
+		t.Errorf("font: got %d pt %q, want 9 pt Segoe UI", dlg.PointSize, dlg.FaceName)
+	}
+}
+
+func TestEncodeDialogErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		dlg  _DescDialog
+	}{
+		{"dialog style", _DescDialog{Style: "WS_POPUP|WS_NOPE"}},
+		{"dialog ex style", _DescDialog{ExStyle: "WS_EX_NOPE"}},
+		{"control style", _DescDialog{Controls: []_DescControl{{Class: "Button", Style: "BS_NOPE"}}}},
+		{"control ex style", _DescDialog{Controls: []_DescControl{{Class: "Button", ExStyle: "nope"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := encodeDialog(&tt.dlg); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
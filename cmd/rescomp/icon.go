@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Splits the contents of an .ico file into the images, each one becoming an
+// RT_ICON resource with sequential IDs starting at firstImgId, and the
+// RT_GROUP_ICON directory which references them.
+func encodeIconGroup(ico []byte, firstImgId uint16) (group []byte, images [][]byte, err error) {
+	if len(ico) < 6 ||
+		binary.LittleEndian.Uint16(ico[0:]) != 0 || // ICONDIR.idReserved
+		binary.LittleEndian.Uint16(ico[2:]) != 1 { // ICONDIR.idType
+		return nil, nil, errors.New("not an .ico file")
+	}
+	count := int(binary.LittleEndian.Uint16(ico[4:]))
+	if count == 0 || len(ico) < 6+16*count {
+		return nil, nil, errors.New("truncated .ico file")
+	}
+
+	group = make([]byte, 0, 6+14*count)
+	group = append(group, ico[:6]...) // GRPICONDIR has the same header
+
+	for i := 0; i < count; i++ {
+		entry := ico[6+16*i:] // ICONDIRENTRY
+		size := int(binary.LittleEndian.Uint32(entry[8:]))
+		offset := int(binary.LittleEndian.Uint32(entry[12:]))
+		if offset < 0 || size < 0 || offset+size > len(ico) {
+			return nil, nil, errors.New("invalid image bounds in .ico file")
+		}
+		images = append(images, ico[offset:offset+size])
+
+		group = append(group, entry[:12]...) // GRPICONDIRENTRY: same fields, but the offset
+		group = appendU16(group, firstImgId+uint16(i))
+	}
+	return group, images, nil
+}
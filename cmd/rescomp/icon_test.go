@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+// Builds an .ico file with the given images; the width and height of each
+// entry are its index plus 16.
+func makeIco(images ...[]byte) []byte {
+	ico := testbuf.Words(uint16(0), uint16(1), uint16(len(images))) // ICONDIR
+	offset := uint32(6 + 16*len(images))
+	for i, img := range images { // ICONDIRENTRY
+		ico = append(ico, byte(16+i), byte(16+i), 0, 0)
+		ico = append(ico, testbuf.Words(uint16(1), uint16(32), uint32(len(img)), offset)...)
+		offset += uint32(len(img))
+	}
+	for _, img := range images {
+		ico = append(ico, img...)
+	}
+	return ico
+}
+
+func TestEncodeIconGroup(t *testing.T) {
+	img1, img2 := []byte("first image"), []byte("second")
+	ico := makeIco(img1, img2)
+
+	group, images, err := encodeIconGroup(ico, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantGroup := testbuf.Words(
+		uint16(0), uint16(1), uint16(2), // GRPICONDIR
+		[]byte{16, 16, 0, 0}, uint16(1), uint16(32), uint32(len(img1)), uint16(7),
+		[]byte{17, 17, 0, 0}, uint16(1), uint16(32), uint32(len(img2)), uint16(8),
+	)
+	if !bytes.Equal(group, wantGroup) {
+		t.Errorf("group: got % x\nwant % x", group, wantGroup)
+	}
+	if len(images) != 2 || !bytes.Equal(images[0], img1) || !bytes.Equal(images[1], img2) {
+		t.Errorf("images: got %q", images)
+	}
+}
+
+func TestEncodeIconGroupErrors(t *testing.T) {
+	valid := makeIco([]byte("image"))
+
+	badBounds := append([]byte(nil), valid...)
+	badBounds[6+8] = 0xff // dwBytesInRes beyond the end of the file
+
+	cursor := append([]byte(nil), valid...)
+	cursor[2] = 2 // idType of a .cur file
+
+	tests := []struct {
+		name string
+		ico  []byte
+	}{
+		{"empty", nil},
+		{"cursor", cursor},
+		{"no images", testbuf.Words(uint16(0), uint16(1), uint16(0))},
+		{"truncated directory", valid[:6+8]},
+		{"bad bounds", badBounds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := encodeIconGroup(tt.ico, 1); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
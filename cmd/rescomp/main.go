@@ -0,0 +1,110 @@
+// Command rescomp compiles the resources of a native Win32 application into
+// .syso files, which are automatically linked by the Go toolchain when placed
+// in the folder of the main package. It runs on any operating system.
+//
+// The resources are described in a JSON file, whose file paths are relative to
+// the JSON file itself:
+//
+//	{
+//		"language": 1033,
+//		"manifest": "app.exe.manifest",
+//		"icons": [
+//			{ "id": 101, "file": "app.ico" }
+//		],
+//		"version": {
+//			"fileVersion": "1.0.2.0",
+//			"strings": {
+//				"CompanyName": "Acme",
+//				"ProductName": "Notes"
+//			}
+//		},
+//		"strings": {
+//			"1000": "Untitled"
+//		},
+//		"menus": [
+//			{ "id": 200, "items": [
+//				{ "text": "&File", "items": [
+//					{ "text": "&Open...\tCtrl+O", "id": 1001 },
+//					{ "separator": true },
+//					{ "text": "E&xit", "id": 1002 }
+//				] }
+//			] }
+//		],
+//		"accelerators": [
+//			{ "id": 300, "keys": [
+//				{ "key": "Ctrl+O", "cmd": 1001 }
+//			] }
+//		],
+//		"dialogs": [
+//			{ "id": 400, "title": "About", "cx": 180, "cy": 60,
+//				"style": "WS_POPUP|WS_CAPTION|WS_SYSMENU|DS_MODALFRAME|DS_CENTER",
+//				"controls": [
+//					{ "id": -1, "class": "Static", "text": "Notes 1.0", "x": 10, "y": 10, "cx": 160, "cy": 10 },
+//					{ "id": 1, "class": "Button", "text": "OK", "style": "WS_TABSTOP|BS_DEFPUSHBUTTON",
+//						"x": 65, "y": 38, "cx": 50, "cy": 14 }
+//				] }
+//		]
+//	}
+//
+// All resource IDs are numbers; named IDs, like "MAINICON", are not supported.
+//
+// Usage:
+//
+//	rescomp [-arch 386,amd64] [-o rsrc] resources.json
+//
+// For each architecture, a file named rsrc_windows_<arch>.syso is written.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	arches := flag.String("arch", "386,amd64", "comma-separated target architectures: 386, amd64")
+	out := flag.String("o", "rsrc", "output file prefix, to which \"_windows_<arch>.syso\" is appended")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: rescomp [flags] resources.json")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), *out, strings.Split(*arches, ",")); err != nil {
+		fmt.Fprintln(os.Stderr, "rescomp:", err)
+		os.Exit(1)
+	}
+}
+
+// Compiles the description file, writing one .syso file for each
+// architecture.
+func run(descPath, outPrefix string, arches []string) error {
+	desc, err := loadDesc(descPath)
+	if err != nil {
+		return err
+	}
+	tree, err := desc.compile(filepath.Dir(descPath))
+	if err != nil {
+		return err
+	}
+	rsrc, relocs := tree.serialize()
+
+	for _, arch := range arches {
+		arch = strings.TrimSpace(arch)
+		coff, err := writeCoff(rsrc, relocs, arch)
+		if err != nil {
+			return err
+		}
+		outPath := outPrefix + "_windows_" + arch + ".syso"
+		if err := os.WriteFile(outPath, coff, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
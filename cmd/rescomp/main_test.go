@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/peres"
+)
+
+const _ROUND_TRIP_DESC = `{
+	"language": 1046,
+	"manifest": "app.exe.manifest",
+	"icons": [
+		{ "id": 101, "file": "app.ico" },
+		{ "id": 102, "file": "app.ico" }
+	],
+	"version": {
+		"fileVersion": "1.0.2.0",
+		"productVersion": "1.1",
+		"fileType": "dll",
+		"strings": { "CompanyName": "Acme", "ProductName": "Notes" }
+	},
+	"strings": { "1000": "Untitled", "1017": "Saved" },
+	"menus": [
+		{ "id": 200, "items": [ { "text": "&File", "items": [ { "text": "E&xit", "id": 1002 } ] } ] }
+	],
+	"accelerators": [
+		{ "id": 300, "keys": [ { "key": "Ctrl+O", "cmd": 1001 } ] }
+	],
+	"dialogs": [
+		{ "id": 400, "title": "About", "cx": 180, "cy": 60, "menu": 200,
+			"controls": [
+				{ "id": 1, "class": "Button", "text": "OK", "x": 65, "y": 38, "cx": 50, "cy": 14 }
+			] }
+	]
+}`
+
+// Compiles a description with all the resource types for each architecture,
+// then reads the resources back with peres.
+func TestRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	ico := makeIco([]byte("16x16 image"), []byte("32x32 image, larger"))
+	manifest := `<assembly manifestVersion="1.0"/>`
+	for name, data := range map[string][]byte{
+		"res.json":         []byte(_ROUND_TRIP_DESC),
+		"app.ico":          ico,
+		"app.exe.manifest": []byte(manifest),
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	arches := []string{"386", "amd64"}
+	if err := run(filepath.Join(dir, "res.json"), filepath.Join(dir, "rsrc"), arches); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, arch := range arches {
+		t.Run(arch, func(t *testing.T) {
+			coff, err := os.ReadFile(filepath.Join(dir, "rsrc_windows_"+arch+".syso"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := peres.NewFile(bytes.NewReader(linkRsrc(t, coff)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, res := range f.Resources() {
+				if res.Lang != 1046 {
+					t.Errorf("resource %s/%s: got language %d", res.Type, res.Name, res.Lang)
+				}
+			}
+
+			if got, err := f.Manifest(); err != nil || got != manifest {
+				t.Errorf("manifest: got %q, %v", got, err)
+			}
+
+			for _, id := range []uint16{101, 102} {
+				if got, err := f.Icon(peres.ResIdInt(id)); err != nil || !bytes.Equal(got, ico) {
+					t.Errorf("icon %d: got % x, %v", id, got, err)
+				}
+			}
+			if n := len(f.ResourcesOfType(peres.ResIdInt(peres.RT_ICON))); n != 4 {
+				t.Errorf("got %d RT_ICON images, want 4", n)
+			}
+
+			vi, err := f.VersionInfo()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vi.FileVersionStr() != "1.0.2.0" || vi.ProductVersionStr() != "1.1.0.0" {
+				t.Errorf("versions: got %s and %s", vi.FileVersionStr(), vi.ProductVersionStr())
+			}
+			if vi.FileType != _VFT_DLL {
+				t.Errorf("file type: got %d", vi.FileType)
+			}
+			for key, want := range map[string]string{
+				"CompanyName":    "Acme",
+				"ProductName":    "Notes",
+				"FileVersion":    "1.0.2.0",
+				"ProductVersion": "1.1",
+			} {
+				if got, ok := vi.String(key); !ok || got != want {
+					t.Errorf("version string %s: got %q, want %q", key, got, want)
+				}
+			}
+			wantTrans := []peres.VersionTranslation{{LangId: 1046, CodePage: 1200}}
+			if !reflect.DeepEqual(vi.Translations, wantTrans) {
+				t.Errorf("translations: got %+v", vi.Translations)
+			}
+
+			strs, err := f.Strings()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := map[uint16]string{1000: "Untitled", 1017: "Saved"}; !reflect.DeepEqual(strs, want) {
+				t.Errorf("strings: got %v", strs)
+			}
+
+			menu, ok := f.Find(peres.ResIdInt(peres.RT_MENU), peres.ResIdInt(200))
+			if want := encodeMenu([]_DescMenuItem{{Text: "&File", Items: []_DescMenuItem{{Text: "E&xit", Id: 1002}}}}); !ok || !bytes.Equal(menu.Data, want) {
+				t.Errorf("menu: got % x, found %v", menu.Data, ok)
+			}
+
+			accel, ok := f.Find(peres.ResIdInt(peres.RT_ACCELERATOR), peres.ResIdInt(300))
+			if want, _ := encodeAccelTable([]_DescAccel{{Key: "Ctrl+O", Cmd: 1001}}); !ok || !bytes.Equal(accel.Data, want) {
+				t.Errorf("accelerators: got % x, found %v", accel.Data, ok)
+			}
+
+			dlg, err := f.Dialog(peres.ResIdInt(400))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dlg.Title != "About" || dlg.Menu != peres.ResIdInt(200) || len(dlg.Controls) != 1 ||
+				dlg.Controls[0].Title != peres.ResIdStr("OK") {
+				t.Errorf("dialog: got %+v", dlg)
+			}
+		})
+	}
+}
+
+// Links the .rsrc section of an object file written by writeCoff into a
+// minimal PE32+ image, applying the relocations, so it can be read by peres.
+func linkRsrc(t *testing.T, coff []byte) []byte {
+	t.Helper()
+	obj, err := pe.NewFile(bytes.NewReader(coff))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+
+	sec := obj.Sections[0]
+	rsrc, err := sec.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const secRva = 0x1000
+	for _, rel := range sec.Relocs { // DIR32NB and ADDR32NB: add the RVA of the section
+		field := rsrc[rel.VirtualAddress:]
+		binary.LittleEndian.PutUint32(field, binary.LittleEndian.Uint32(field)+secRva)
+	}
+
+	const lfanew, optHdrSize, rawOff = 64, 240, 0x200
+	img := make([]byte, rawOff+len(rsrc))
+	copy(img, "MZ")
+	binary.LittleEndian.PutUint32(img[0x3c:], lfanew)
+	copy(img[lfanew:], "PE\x00\x00")
+
+	hdr := img[lfanew+4:] // IMAGE_FILE_HEADER
+	binary.LittleEndian.PutUint16(hdr[0:], obj.Machine)
+	binary.LittleEndian.PutUint16(hdr[2:], 1) // NumberOfSections
+	binary.LittleEndian.PutUint16(hdr[16:], optHdrSize)
+	binary.LittleEndian.PutUint16(hdr[18:], 0x0022) // executable, large address aware
+
+	opt := hdr[20:] // IMAGE_OPTIONAL_HEADER64
+	binary.LittleEndian.PutUint16(opt[0:], 0x020b)
+	binary.LittleEndian.PutUint32(opt[32:], 0x1000) // SectionAlignment
+	binary.LittleEndian.PutUint32(opt[36:], rawOff) // FileAlignment
+	binary.LittleEndian.PutUint32(opt[56:], uint32(secRva+alignUp(len(rsrc), 0x1000)))
+	binary.LittleEndian.PutUint32(opt[60:], rawOff) // SizeOfHeaders
+	binary.LittleEndian.PutUint32(opt[108:], 16)    // NumberOfRvaAndSizes
+	binary.LittleEndian.PutUint32(opt[112+8*pe.IMAGE_DIRECTORY_ENTRY_RESOURCE:], secRva)
+	binary.LittleEndian.PutUint32(opt[116+8*pe.IMAGE_DIRECTORY_ENTRY_RESOURCE:], uint32(len(rsrc)))
+
+	secHdr := opt[optHdrSize:] // IMAGE_SECTION_HEADER
+	copy(secHdr[0:8], ".rsrc")
+	binary.LittleEndian.PutUint32(secHdr[8:], uint32(len(rsrc))) // VirtualSize
+	binary.LittleEndian.PutUint32(secHdr[12:], secRva)
+	binary.LittleEndian.PutUint32(secHdr[16:], uint32(len(rsrc))) // SizeOfRawData
+	binary.LittleEndian.PutUint32(secHdr[20:], rawOff)
+	binary.LittleEndian.PutUint32(secHdr[36:], sec.Characteristics)
+
+	copy(img[rawOff:], rsrc)
+	return img
+}
@@ -0,0 +1,49 @@
+package main
+
+// Menu item flags of the MENUITEMTEMPLATE.
+const (
+	_MF_GRAYED  uint16 = 0x0001
+	_MF_CHECKED uint16 = 0x0008
+	_MF_POPUP   uint16 = 0x0010
+	_MF_END     uint16 = 0x0080
+)
+
+// Encodes the items into an RT_MENU resource, with a MENUITEMTEMPLATEHEADER
+// followed by the items.
+func encodeMenu(items []_DescMenuItem) []byte {
+	buf := make([]byte, 4, 128) // wVersion and cbHeaderSize are zero
+	return appendMenuItems(buf, items)
+}
+
+// Appends the items of a menu level; the last one is marked with MF_END.
+func appendMenuItems(buf []byte, items []_DescMenuItem) []byte {
+	for i := range items {
+		item := &items[i]
+		var flags uint16
+		if item.Grayed {
+			flags |= _MF_GRAYED
+		}
+		if item.Checked {
+			flags |= _MF_CHECKED
+		}
+		if len(item.Items) > 0 {
+			flags |= _MF_POPUP
+		}
+		if i == len(items)-1 {
+			flags |= _MF_END
+		}
+
+		buf = appendU16(buf, flags)
+		if len(item.Items) > 0 { // popups have no ID
+			buf = appendSz(buf, item.Text)
+			buf = appendMenuItems(buf, item.Items)
+		} else if item.Separator { // a separator is an item with no ID and no text
+			buf = appendU16(buf, 0)
+			buf = appendSz(buf, "")
+		} else {
+			buf = appendU16(buf, item.Id)
+			buf = appendSz(buf, item.Text)
+		}
+	}
+	return buf
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+func TestEncodeMenu(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []_DescMenuItem
+		want  []byte
+	}{
+		{
+			name:  "single item",
+			items: []_DescMenuItem{{Text: "&Go", Id: 10}},
+			want:  testbuf.Words(uint32(0), _MF_END, uint16(10), "&Go"),
+		},
+		{
+			name: "nested popup",
+			items: []_DescMenuItem{
+				{Text: "&File", Items: []_DescMenuItem{
+					{Text: "&Open", Id: 1001, Checked: true},
+					{Separator: true},
+					{Text: "E&xit", Id: 1002, Grayed: true},
+				}},
+				{Text: "&Help", Id: 1003},
+			},
+			want: testbuf.Words(
+				uint32(0), // MENUITEMTEMPLATEHEADER
+				_MF_POPUP, "&File",
+				_MF_CHECKED, uint16(1001), "&Open",
+				uint16(0), uint16(0), "",
+				_MF_GRAYED|_MF_END, uint16(1002), "E&xit",
+				_MF_END, uint16(1003), "&Help",
+			),
+		},
+		{
+			name: "popup as last item",
+			items: []_DescMenuItem{
+				{Text: "&View", Items: []_DescMenuItem{{Text: "&Zoom", Id: 5}}},
+			},
+			want: testbuf.Words(uint32(0),
+				_MF_POPUP|_MF_END, "&View",
+				_MF_END, uint16(5), "&Zoom",
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encodeMenu(tt.items); !bytes.Equal(got, tt.want) {
+				t.Errorf("got % x\nwant % x", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"unicode/utf16"
+)
+
+// Predefined resource types.
+const (
+	_RT_ICON        uint16 = 3
+	_RT_MENU        uint16 = 4
+	_RT_DIALOG      uint16 = 5
+	_RT_STRING      uint16 = 6
+	_RT_ACCELERATOR uint16 = 9
+	_RT_GROUP_ICON  uint16 = 14
+	_RT_VERSION     uint16 = 16
+	_RT_MANIFEST    uint16 = 24
+)
+
+// A single resource, identified by type, ID and language.
+type _ResEntry struct {
+	typ, id, lang uint16
+	data          []byte
+}
+
+// All the resources to be written in the .rsrc section.
+type _ResTree struct {
+	entries []_ResEntry
+}
+
+// Adds a resource to the tree.
+func (t *_ResTree) add(typ, id, lang uint16, data []byte) {
+	t.entries = append(t.entries, _ResEntry{typ, id, lang, data})
+}
+
+// Sorts the entries, which is required by the resource directory, and returns
+// an error if two of them have the same type, ID and language.
+func (t *_ResTree) checkDuplicates() error {
+	sort.SliceStable(t.entries, func(i, j int) bool {
+		a, b := &t.entries[i], &t.entries[j]
+		if a.typ != b.typ {
+			return a.typ < b.typ
+		}
+		if a.id != b.id {
+			return a.id < b.id
+		}
+		return a.lang < b.lang
+	})
+
+	for i := 1; i < len(t.entries); i++ {
+		a, b := &t.entries[i-1], &t.entries[i]
+		if a.typ == b.typ && a.id == b.id && a.lang == b.lang {
+			return fmt.Errorf("duplicated resource: type %d, ID %d, language %d", a.typ, a.id, a.lang)
+		}
+	}
+	return nil
+}
+
+// Directory node: the children are either other directories, or the indexes
+// of the entries, at the language level.
+type _ResDir struct {
+	ids      []uint16
+	subdirs  []*_ResDir
+	entryIdx []int
+	offset   int
+}
+
+// Lays out the .rsrc section, with the directories first, then the data
+// entries, then the resource data. Returns the raw section, and the offsets of
+// the OffsetToData fields, which hold RVAs and must be relocated.
+//
+// The entries must be sorted by checkDuplicates.
+func (t *_ResTree) serialize() (rsrc []byte, relocs []uint32) {
+	root := &_ResDir{}
+	for i := range t.entries {
+		e := &t.entries[i]
+		dirType := root.child(e.typ)
+		dirId := dirType.child(e.id)
+		dirId.ids = append(dirId.ids, e.lang)
+		dirId.entryIdx = append(dirId.entryIdx, i)
+	}
+
+	levels := [][]*_ResDir{{root}, root.subdirs, nil} // breadth-first
+	for _, dirType := range root.subdirs {
+		levels[2] = append(levels[2], dirType.subdirs...)
+	}
+
+	off := 0
+	for _, level := range levels {
+		for _, dir := range level {
+			dir.offset = off
+			off += 16 + 8*len(dir.ids) // IMAGE_RESOURCE_DIRECTORY + entries
+		}
+	}
+	dataEntriesOff := off
+	off += 16 * len(t.entries) // IMAGE_RESOURCE_DATA_ENTRY
+
+	dataOffs := make([]int, len(t.entries))
+	for i := range t.entries {
+		off = alignUp(off, 8)
+		dataOffs[i] = off
+		off += len(t.entries[i].data)
+	}
+
+	rsrc = make([]byte, alignUp(off, 8))
+	relocs = make([]uint32, 0, len(t.entries))
+
+	for _, level := range levels {
+		for _, dir := range level {
+			dest := rsrc[dir.offset:]
+			binary.LittleEndian.PutUint16(dest[14:], uint16(len(dir.ids))) // NumberOfIdEntries
+			for i, id := range dir.ids {
+				entry := dest[16+8*i:]
+				binary.LittleEndian.PutUint32(entry[0:], uint32(id))
+				if dir.subdirs != nil {
+					binary.LittleEndian.PutUint32(entry[4:], 0x8000_0000|uint32(dir.subdirs[i].offset))
+				} else {
+					binary.LittleEndian.PutUint32(entry[4:], uint32(dataEntriesOff+16*dir.entryIdx[i]))
+				}
+			}
+		}
+	}
+
+	for i := range t.entries {
+		e := &t.entries[i]
+		dest := rsrc[dataEntriesOff+16*i:]
+		binary.LittleEndian.PutUint32(dest[0:], uint32(dataOffs[i])) // RVA, relative to the section
+		binary.LittleEndian.PutUint32(dest[4:], uint32(len(e.data)))
+		relocs = append(relocs, uint32(dataEntriesOff+16*i))
+		copy(rsrc[dataOffs[i]:], e.data)
+	}
+	return rsrc, relocs
+}
+
+// Returns the subdirectory with the given ID, appending it if needed. Since
+// the entries are sorted, the IDs are appended in ascending order.
+func (d *_ResDir) child(id uint16) *_ResDir {
+	if n := len(d.ids); n > 0 && d.ids[n-1] == id {
+		return d.subdirs[n-1]
+	}
+	sub := &_ResDir{}
+	d.ids = append(d.ids, id)
+	d.subdirs = append(d.subdirs, sub)
+	return sub
+}
+
+// Rounds n up to a multiple of align, which must be a power of 2.
+func alignUp(n, align int) int {
+	return (n + align - 1) &^ (align - 1)
+}
+
+// Appends a little-endian WORD.
+func appendU16(buf []byte, v uint16) []byte {
+	return binary.LittleEndian.AppendUint16(buf, v)
+}
+
+// Appends a little-endian DWORD.
+func appendU32(buf []byte, v uint32) []byte {
+	return binary.LittleEndian.AppendUint32(buf, v)
+}
+
+// Appends a null-terminated UTF-16 string.
+func appendSz(buf []byte, s string) []byte {
+	for _, ch := range utf16.Encode([]rune(s)) {
+		buf = appendU16(buf, ch)
+	}
+	return appendU16(buf, 0)
+}
+
+// Appends zeros until the length is a multiple of align.
+func appendPad(buf []byte, align int) []byte {
+	for len(buf)%align != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
@@ -0,0 +1,28 @@
+package main
+
+// Encodes the strings into RT_STRING blocks of 16 strings each, keyed by the
+// block ID, which is the string ID divided by 16, plus one.
+func encodeStringTables(strs map[uint16]string) map[uint16][]byte {
+	blocks := make(map[uint16]*[16]string)
+	for id, s := range strs {
+		blockId := id/16 + 1
+		if blocks[blockId] == nil {
+			blocks[blockId] = &[16]string{}
+		}
+		blocks[blockId][id%16] = s
+	}
+
+	tables := make(map[uint16][]byte, len(blocks))
+	for blockId, block := range blocks {
+		var buf []byte
+		for _, s := range block {
+			// Each string is prefixed by its length, and has no terminating null.
+			sz := appendSz(nil, s)
+			sz = sz[:len(sz)-2]
+			buf = appendU16(buf, uint16(len(sz)/2))
+			buf = append(buf, sz...)
+		}
+		tables[blockId] = buf
+	}
+	return tables
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+func TestEncodeStringTables(t *testing.T) {
+	tables := encodeStringTables(map[uint16]string{
+		0:  "Hi",
+		15: "Olá",
+		17: "x",
+	})
+
+	if len(tables) != 2 {
+		t.Fatalf("got %d blocks, want 2", len(tables))
+	}
+
+	emptyStrs := func(n int) []byte { return make([]byte, 2*n) } // zero lengths
+
+	want1 := testbuf.Words(
+		uint16(2), uint16('H'), uint16('i'), // no terminating null
+		emptyStrs(14),
+		uint16(3), uint16('O'), uint16('l'), uint16('á'),
+	)
+	if !bytes.Equal(tables[1], want1) {
+		t.Errorf("block 1: got % x\nwant % x", tables[1], want1)
+	}
+
+	want2 := testbuf.Words(
+		emptyStrs(1),
+		uint16(1), uint16('x'),
+		emptyStrs(14),
+	)
+	if !bytes.Equal(tables[2], want2) {
+		t.Errorf("block 2: got % x\nwant % x", tables[2], want2)
+	}
+}
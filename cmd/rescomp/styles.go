@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parses a style like "WS_CHILD|WS_TABSTOP|BS_DEFPUSHBUTTON"; each part can
+// also be a decimal or hexadecimal number.
+func parseStyle(s string) (uint32, error) {
+	var style uint32
+	for _, part := range strings.Split(s, "|") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if n, err := strconv.ParseUint(part, 0, 32); err == nil {
+			style |= uint32(n)
+		} else if v, ok := _styles[strings.ToUpper(part)]; ok {
+			style |= v
+		} else {
+			return 0, fmt.Errorf("unknown style %q", part)
+		}
+	}
+	return style, nil
+}
+
+// Window, dialog and control styles which can be used by name.
+var _styles = map[string]uint32{
+	"BS_PUSHBUTTON":               0x00000000,
+	"BS_DEFPUSHBUTTON":            0x00000001,
+	"BS_CHECKBOX":                 0x00000002,
+	"BS_AUTOCHECKBOX":             0x00000003,
+	"BS_RADIOBUTTON":              0x00000004,
+	"BS_3STATE":                   0x00000005,
+	"BS_AUTO3STATE":               0x00000006,
+	"BS_GROUPBOX":                 0x00000007,
+	"BS_USERBUTTON":               0x00000008,
+	"BS_AUTORADIOBUTTON":          0x00000009,
+	"BS_PUSHBOX":                  0x0000000a,
+	"BS_OWNERDRAW":                0x0000000b,
+	"BS_TYPEMASK":                 0x0000000f,
+	"BS_LEFTTEXT":                 0x00000020,
+	"BS_TEXT":                     0x00000000,
+	"BS_ICON":                     0x00000040,
+	"BS_BITMAP":                   0x00000080,
+	"BS_LEFT":                     0x00000100,
+	"BS_RIGHT":                    0x00000200,
+	"BS_CENTER":                   0x00000300,
+	"BS_TOP":                      0x00000400,
+	"BS_BOTTOM":                   0x00000800,
+	"BS_VCENTER":                  0x00000c00,
+	"BS_PUSHLIKE":                 0x00001000,
+	"BS_MULTILINE":                0x00002000,
+	"BS_NOTIFY":                   0x00004000,
+	"BS_FLAT":                     0x00008000,
+	"BS_RIGHTBUTTON":              0x00000020,
+	"CBS_SIMPLE":                  0x00000001,
+	"CBS_DROPDOWN":                0x00000002,
+	"CBS_DROPDOWNLIST":            0x00000003,
+	"CBS_OWNERDRAWFIXED":          0x00000010,
+	"CBS_OWNERDRAWVARIABLE":       0x00000020,
+	"CBS_AUTOHSCROLL":             0x00000040,
+	"CBS_OEMCONVERT":              0x00000080,
+	"CBS_SORT":                    0x00000100,
+	"CBS_HASSTRINGS":              0x00000200,
+	"CBS_NOINTEGRALHEIGHT":        0x00000400,
+	"CBS_DISABLENOSCROLL":         0x00000800,
+	"CBS_UPPERCASE":               0x00002000,
+	"CBS_LOWERCASE":               0x00004000,
+	"DS_ABSALIGN":                 0x00000001,
+	"DS_SYSMODAL":                 0x00000002,
+	"DS_3DLOOK":                   0x00000004,
+	"DS_FIXEDSYS":                 0x00000008,
+	"DS_NOFAILCREATE":             0x00000010,
+	"DS_LOCALEDIT":                0x00000020,
+	"DS_SETFONT":                  0x00000040,
+	"DS_MODALFRAME":               0x00000080,
+	"DS_NOIDLEMSG":                0x00000100,
+	"DS_SETFOREGROUND":            0x00000200,
+	"DS_CONTROL":                  0x00000400,
+	"DS_CENTER":                   0x00000800,
+	"DS_CENTERMOUSE":              0x00001000,
+	"DS_CONTEXTHELP":              0x00002000,
+	"DS_SHELLFONT":                0x00000048,
+	"ES_LEFT":                     0x00000000,
+	"ES_CENTER":                   0x00000001,
+	"ES_RIGHT":                    0x00000002,
+	"ES_MULTILINE":                0x00000004,
+	"ES_UPPERCASE":                0x00000008,
+	"ES_LOWERCASE":                0x00000010,
+	"ES_PASSWORD":                 0x00000020,
+	"ES_AUTOVSCROLL":              0x00000040,
+	"ES_AUTOHSCROLL":              0x00000080,
+	"ES_NOHIDESEL":                0x00000100,
+	"ES_OEMCONVERT":               0x00000400,
+	"ES_READONLY":                 0x00000800,
+	"ES_WANTRETURN":               0x00001000,
+	"ES_NUMBER":                   0x00002000,
+	"ES_NOOLEDRAGDROP":            0x00000008,
+	"ES_DISABLENOSCROLL":          0x00002000,
+	"ES_SUNKEN":                   0x00004000,
+	"ES_SAVESEL":                  0x00008000,
+	"ES_SELECTIONBAR":             0x01000000,
+	"LBS_NOTIFY":                  0x00000001,
+	"LBS_SORT":                    0x00000002,
+	"LBS_NOREDRAW":                0x00000004,
+	"LBS_MULTIPLESEL":             0x00000008,
+	"LBS_OWNERDRAWFIXED":          0x00000010,
+	"LBS_OWNERDRAWVARIABLE":       0x00000020,
+	"LBS_HASSTRINGS":              0x00000040,
+	"LBS_USETABSTOPS":             0x00000080,
+	"LBS_NOINTEGRALHEIGHT":        0x00000100,
+	"LBS_MULTICOLUMN":             0x00000200,
+	"LBS_WANTKEYBOARDINPUT":       0x00000400,
+	"LBS_EXTENDEDSEL":             0x00000800,
+	"LBS_DISABLENOSCROLL":         0x00001000,
+	"LBS_NODATA":                  0x00002000,
+	"LBS_NOSEL":                   0x00004000,
+	"LBS_COMBOBOX":                0x00008000,
+	"LBS_STANDARD":                0x00a00003,
+	"SBS_HORZ":                    0x00000000,
+	"SBS_VERT":                    0x00000001,
+	"SBS_TOPALIGN":                0x00000002,
+	"SBS_LEFTALIGN":               0x00000002,
+	"SBS_BOTTOMALIGN":             0x00000004,
+	"SBS_RIGHTALIGN":              0x00000004,
+	"SBS_SIZEBOXTOPLEFTALIGN":     0x00000002,
+	"SBS_SIZEBOXBOTTOMRIGHTALIGN": 0x00000004,
+	"SBS_SIZEBOX":                 0x00000008,
+	"SBS_SIZEGRIP":                0x00000010,
+	"SS_LEFT":                     0x00000000,
+	"SS_CENTER":                   0x00000001,
+	"SS_RIGHT":                    0x00000002,
+	"SS_ICON":                     0x00000003,
+	"SS_BLACKRECT":                0x00000004,
+	"SS_GRAYRECT":                 0x00000005,
+	"SS_WHITERECT":                0x00000006,
+	"SS_BLACKFRAME":               0x00000007,
+	"SS_GRAYFRAME":                0x00000008,
+	"SS_WHITEFRAME":               0x00000009,
+	"SS_USERITEM":                 0x0000000a,
+	"SS_SIMPLE":                   0x0000000b,
+	"SS_LEFTNOWORDWRAP":           0x0000000c,
+	"SS_OWNERDRAW":                0x0000000d,
+	"SS_BITMAP":                   0x0000000e,
+	"SS_ENHMETAFILE":              0x0000000f,
+	"SS_ETCHEDHORZ":               0x00000010,
+	"SS_ETCHEDVERT":               0x00000011,
+	"SS_ETCHEDFRAME":              0x00000012,
+	"SS_TYPEMASK":                 0x0000001f,
+	"SS_REALSIZECONTROL":          0x00000040,
+	"SS_NOPREFIX":                 0x00000080,
+	"SS_NOTIFY":                   0x00000100,
+	"SS_CENTERIMAGE":              0x00000200,
+	"SS_RIGHTJUST":                0x00000400,
+	"SS_REALSIZEIMAGE":            0x00000800,
+	"SS_SUNKEN":                   0x00001000,
+	"SS_EDITCONTROL":              0x00002000,
+	"SS_ENDELLIPSIS":              0x00004000,
+	"SS_PATHELLIPSIS":             0x00008000,
+	"SS_WORDELLIPSIS":             0x0000c000,
+	"SS_ELLIPSISMASK":             0x0000c000,
+	"WS_NONE":                     0x00000000,
+	"WS_OVERLAPPED":               0x00000000,
+	"WS_POPUP":                    0x80000000,
+	"WS_CHILD":                    0x40000000,
+	"WS_MINIMIZE":                 0x20000000,
+	"WS_VISIBLE":                  0x10000000,
+	"WS_DISABLED":                 0x08000000,
+	"WS_CLIPSIBLINGS":             0x04000000,
+	"WS_CLIPCHILDREN":             0x02000000,
+	"WS_MAXIMIZE":                 0x01000000,
+	"WS_CAPTION":                  0x00c00000,
+	"WS_BORDER":                   0x00800000,
+	"WS_DLGFRAME":                 0x00400000,
+	"WS_VSCROLL":                  0x00200000,
+	"WS_HSCROLL":                  0x00100000,
+	"WS_SYSMENU":                  0x00080000,
+	"WS_THICKFRAME":               0x00040000,
+	"WS_GROUP":                    0x00020000,
+	"WS_TABSTOP":                  0x00010000,
+	"WS_MINIMIZEBOX":              0x00020000,
+	"WS_MAXIMIZEBOX":              0x00010000,
+	"WS_TILED":                    0x00000000,
+	"WS_ICONIC":                   0x20000000,
+	"WS_SIZEBOX":                  0x00040000,
+	"WS_TILEDWINDOW":              0x00cf0000,
+	"WS_OVERLAPPEDWINDOW":         0x00cf0000,
+	"WS_POPUPWINDOW":              0x80880000,
+	"WS_CHILDWINDOW":              0x40000000,
+	"WS_EX_NONE":                  0x00000000,
+	"WS_EX_DLGMODALFRAME":         0x00000001,
+	"WS_EX_NOPARENTNOTIFY":        0x00000004,
+	"WS_EX_TOPMOST":               0x00000008,
+	"WS_EX_ACCEPTFILES":           0x00000010,
+	"WS_EX_TRANSPARENT":           0x00000020,
+	"WS_EX_MDICHILD":              0x00000040,
+	"WS_EX_TOOLWINDOW":            0x00000080,
+	"WS_EX_WINDOWEDGE":            0x00000100,
+	"WS_EX_CLIENTEDGE":            0x00000200,
+	"WS_EX_CONTEXTHELP":           0x00000400,
+	"WS_EX_RIGHT":                 0x00001000,
+	"WS_EX_LEFT":                  0x00000000,
+	"WS_EX_RTLREADING":            0x00002000,
+	"WS_EX_LTRREADING":            0x00000000,
+	"WS_EX_LEFTSCROLLBAR":         0x00004000,
+	"WS_EX_RIGHTSCROLLBAR":        0x00000000,
+	"WS_EX_CONTROLPARENT":         0x00010000,
+	"WS_EX_STATICEDGE":            0x00020000,
+	"WS_EX_APPWINDOW":             0x00040000,
+	"WS_EX_OVERLAPPEDWINDOW":      0x00000300,
+	"WS_EX_PALETTEWINDOW":         0x00000188,
+	"WS_EX_LAYERED":               0x00080000,
+	"WS_EX_NOINHERITLAYOUT":       0x00100000,
+	"WS_EX_NOREDIRECTIONBITMAP":   0x00200000,
+	"WS_EX_LAYOUTRTL":             0x00400000,
+	"WS_EX_COMPOSITED":            0x02000000,
+	"WS_EX_NOACTIVATE":            0x08000000,
+}
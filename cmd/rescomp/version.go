@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	_VS_FFI_SIGNATURE     uint32 = 0xfeef_04bd
+	_VS_FFI_STRUCVERSION  uint32 = 0x0001_0000
+	_VS_FFI_FILEFLAGSMASK uint32 = 0x0000_003f
+	_VOS_NT_WINDOWS32     uint32 = 0x0004_0004
+	_VFT_APP              uint32 = 0x0000_0001
+	_VFT_DLL              uint32 = 0x0000_0002
+	_CP_UNICODE           uint16 = 1200
+)
+
+// Encodes the version information into an RT_VERSION resource: a
+// VS_VERSIONINFO block with a VS_FIXEDFILEINFO, a StringFileInfo with a single
+// string table, and a VarFileInfo with its translation.
+func encodeVersionInfo(ver *_DescVersion, lang uint16) ([]byte, error) {
+	fileMs, fileLs, err := parseVersion(ver.FileVersion)
+	if err != nil {
+		return nil, err
+	}
+	productVersion := ver.ProductVersion
+	if productVersion == "" {
+		productVersion = ver.FileVersion
+	}
+	prodMs, prodLs, err := parseVersion(productVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileType uint32
+	switch strings.ToLower(ver.FileType) {
+	case "", "app":
+		fileType = _VFT_APP
+	case "dll":
+		fileType = _VFT_DLL
+	default:
+		return nil, fmt.Errorf("invalid file type %q", ver.FileType)
+	}
+
+	var ffi []byte // VS_FIXEDFILEINFO
+	for _, v := range [...]uint32{
+		_VS_FFI_SIGNATURE, _VS_FFI_STRUCVERSION,
+		fileMs, fileLs, prodMs, prodLs,
+		_VS_FFI_FILEFLAGSMASK, 0, // dwFileFlags
+		_VOS_NT_WINDOWS32, fileType, 0, // dwFileSubtype
+		0, 0, // dwFileDateMS, dwFileDateLS
+	} {
+		ffi = appendU32(ffi, v)
+	}
+
+	strs := make(map[string]string, len(ver.Strings)+2)
+	for k, v := range ver.Strings {
+		strs[k] = v
+	}
+	if _, has := strs["FileVersion"]; !has && ver.FileVersion != "" {
+		strs["FileVersion"] = ver.FileVersion
+	}
+	if _, has := strs["ProductVersion"]; !has && productVersion != "" {
+		strs["ProductVersion"] = productVersion
+	}
+
+	strNodes := make([][]byte, 0, len(strs))
+	for _, k := range sortedKeys(strs) {
+		val := appendSz(nil, strs[k])
+		strNodes = append(strNodes, versionNode(k, 1, val, uint16(len(val)/2))) // length in WORDs
+	}
+	tableKey := fmt.Sprintf("%04x%04x", lang, _CP_UNICODE)
+	stringFileInfo := versionNode("StringFileInfo", 1, nil, 0,
+		versionNode(tableKey, 1, nil, 0, strNodes...))
+
+	translation := appendU16(appendU16(nil, lang), _CP_UNICODE)
+	varFileInfo := versionNode("VarFileInfo", 1, nil, 0,
+		versionNode("Translation", 0, translation, uint16(len(translation))))
+
+	return versionNode("VS_VERSION_INFO", 0, ffi, uint16(len(ffi)),
+		stringFileInfo, varFileInfo), nil
+}
+
+// Encodes a block of the version information: wLength, wValueLength, wType,
+// szKey, the value and the children, all DWORD-aligned.
+func versionNode(key string, wType uint16, value []byte, valueLen uint16, children ...[]byte) []byte {
+	buf := make([]byte, 6, 64) // wLength, wValueLength and wType are written below
+	buf = appendSz(buf, key)
+	buf = appendPad(buf, 4)
+	buf = append(buf, value...)
+	for _, child := range children {
+		buf = appendPad(buf, 4)
+		buf = append(buf, child...)
+	}
+
+	binary.LittleEndian.PutUint16(buf[0:], uint16(len(buf))) // wLength doesn't count the trailing padding
+	binary.LittleEndian.PutUint16(buf[2:], valueLen)
+	binary.LittleEndian.PutUint16(buf[4:], wType)
+	return buf
+}
+
+// Parses a version like "1.2.3.4" into the most and least significant DWORDs;
+// the missing parts are zero.
+func parseVersion(s string) (ms, ls uint32, err error) {
+	var parts [4]uint32
+	if s != "" {
+		fields := strings.Split(s, ".")
+		if len(fields) > 4 {
+			return 0, 0, fmt.Errorf("invalid version %q", s)
+		}
+		for i, field := range fields {
+			n, err := strconv.ParseUint(strings.TrimSpace(field), 10, 16)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid version %q", s)
+			}
+			parts[i] = uint32(n)
+		}
+	}
+	return parts[0]<<16 | parts[1], parts[2]<<16 | parts[3], nil
+}
@@ -17,6 +17,7 @@ const (
 	DWMAPI
 	GDI32
 	KERNEL32
+	MSFTEDIT
 	OLE32
 	OLEAUT32
 	PSAPI
@@ -28,14 +29,15 @@ const (
 )
 
 var (
-	dllCache [13]*syscall.DLL // Indexed by DLL_INDEX.
+	dllCache [14]*syscall.DLL // Indexed by DLL_INDEX.
 	dllMutex sync.Mutex
-	dllNames = [13]string{ // Indexed by DLL_INDEX.
+	dllNames = [14]string{ // Indexed by DLL_INDEX.
 		"advapi32",
 		"comctl32",
 		"dwmapi",
 		"gdi32",
 		"kernel32",
+		"msftedit",
 		"ole32",
 		"oleaut32",
 		"psapi",
@@ -60,6 +62,12 @@ func loadDll(idx DLL_INDEX, name string) *syscall.DLL {
 	return dllObj
 }
 
+// Loads a system DLL without retrieving any procedure. Useful for DLLs which
+// register window classes when loaded, like msftedit.
+func LoadDll(dllIdx DLL_INDEX) {
+	loadDll(dllIdx, dllNames[dllIdx])
+}
+
 // Dynamically loads a procedure from a system DLL.
 func Load(dllIdx DLL_INDEX, pDestProc **syscall.Proc, procName string) uintptr {
 	if pProc := atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(pDestProc))); pProc != nil {
@@ -0,0 +1,33 @@
+// Package testbuf builds binary fixtures for the tests of the resource
+// packages.
+package testbuf
+
+import (
+	"encoding/binary"
+	"unicode/utf16"
+)
+
+// Builds little-endian data: each uint16 becomes a WORD, each uint32 a DWORD,
+// each string a null-terminated UTF-16 string, and each []byte is copied as
+// is.
+func Words(vals ...any) []byte {
+	var buf []byte
+	for _, val := range vals {
+		switch v := val.(type) {
+		case uint16:
+			buf = binary.LittleEndian.AppendUint16(buf, v)
+		case uint32:
+			buf = binary.LittleEndian.AppendUint32(buf, v)
+		case string:
+			for _, ch := range utf16.Encode([]rune(v)) {
+				buf = binary.LittleEndian.AppendUint16(buf, ch)
+			}
+			buf = binary.LittleEndian.AppendUint16(buf, 0)
+		case []byte:
+			buf = append(buf, v...)
+		default:
+			panic("unsupported type")
+		}
+	}
+	return buf
+}
@@ -4,8 +4,9 @@ package utl
 
 // Internal constants for comctl.
 const (
-	L_MAX_URL_LENGTH = 2048 + 32 + 4
-	MAX_LINKID_TEXT  = 48
+	L_MAX_URL_LENGTH   = 2048 + 32 + 4
+	LPSTR_TEXTCALLBACK = ^uintptr(0) // (LPWSTR)-1
+	MAX_LINKID_TEXT    = 48
 )
 
 // Internal constants for gdi.
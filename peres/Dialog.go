@@ -0,0 +1,116 @@
+package peres
+
+import (
+	"fmt"
+)
+
+// Dialog box template, read from an RT_DIALOG resource, either in the
+// DLGTEMPLATE or in the DLGTEMPLATEEX format. The coordinates are given in
+// dialog units.
+type Dialog struct {
+	Extended     bool // True if the resource is a DLGTEMPLATEEX.
+	HelpId       uint32
+	ExStyle      uint32
+	Style        uint32
+	X, Y, Cx, Cy int16
+	Menu         ResId // Zero value if none.
+	Class        ResId // Zero value for the predefined dialog class.
+	Title        string
+	PointSize    uint16 // The font fields are set only with DS_SETFONT.
+	Weight       uint16
+	Italic       bool
+	CharSet      uint8
+	FaceName     string
+	Controls     []DialogControl
+}
+
+// Control of a [Dialog].
+type DialogControl struct {
+	HelpId       uint32
+	ExStyle      uint32
+	Style        uint32
+	X, Y, Cx, Cy int16
+	Id           uint32
+	Class        ResId // Either a class name or a predefined atom, like 0x0080 for Button.
+	Title        ResId // Either the text or a resource ID, like the icon of a Static.
+	CreationData []byte
+}
+
+const _DS_SETFONT uint32 = 0x0040
+
+// Reads and decodes the RT_DIALOG resource with the given ID.
+func (f *File) Dialog(id ResId) (*Dialog, error) {
+	res, ok := f.Find(ResIdInt(RT_DIALOG), id)
+	if !ok {
+		return nil, fmt.Errorf("dialog %s not found", id)
+	}
+	return ParseDialog(res.Data)
+}
+
+// Decodes the contents of an RT_DIALOG resource.
+func ParseDialog(data []byte) (*Dialog, error) {
+	r := _Reader{data: data}
+	dlg := &Dialog{}
+	var numItems uint16
+
+	if len(data) >= 4 && data[0] == 1 && data[1] == 0 && data[2] == 0xff && data[3] == 0xff {
+		dlg.Extended = true
+		r.u32() // dlgVer and signature
+		dlg.HelpId = r.u32()
+		dlg.ExStyle = r.u32()
+		dlg.Style = r.u32()
+	} else {
+		dlg.Style = r.u32()
+		dlg.ExStyle = r.u32()
+	}
+	numItems = r.u16()
+	dlg.X, dlg.Y, dlg.Cx, dlg.Cy = int16(r.u16()), int16(r.u16()), int16(r.u16()), int16(r.u16())
+	dlg.Menu = r.szOrOrd()
+	dlg.Class = r.szOrOrd()
+	dlg.Title = r.sz()
+
+	if dlg.Style&_DS_SETFONT != 0 {
+		dlg.PointSize = r.u16()
+		if dlg.Extended {
+			dlg.Weight = r.u16()
+			dlg.Italic = r.u8() != 0
+			dlg.CharSet = r.u8()
+		}
+		dlg.FaceName = r.sz()
+	}
+
+	for i := uint16(0); i < numItems && r.err == nil; i++ {
+		r.align(4) // each control is DWORD-aligned
+		var ctrl DialogControl
+		if dlg.Extended {
+			ctrl.HelpId = r.u32()
+			ctrl.ExStyle = r.u32()
+			ctrl.Style = r.u32()
+		} else {
+			ctrl.Style = r.u32()
+			ctrl.ExStyle = r.u32()
+		}
+		ctrl.X, ctrl.Y, ctrl.Cx, ctrl.Cy = int16(r.u16()), int16(r.u16()), int16(r.u16()), int16(r.u16())
+		if dlg.Extended {
+			ctrl.Id = r.u32()
+		} else {
+			ctrl.Id = uint32(r.u16())
+		}
+		ctrl.Class = r.szOrOrd()
+		ctrl.Title = r.szOrOrd()
+
+		extra := int(r.u16())
+		if extra > 0 {
+			if !dlg.Extended {
+				extra -= 2 // the old format counts the size field itself
+			}
+			ctrl.CreationData = r.bytes(extra)
+		}
+		dlg.Controls = append(dlg.Controls, ctrl)
+	}
+
+	if r.err != nil {
+		return nil, fmt.Errorf("invalid dialog template: %w", r.err)
+	}
+	return dlg, nil
+}
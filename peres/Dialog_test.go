@@ -0,0 +1,178 @@
+package peres
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+func TestParseDialog(t *testing.T) {
+	const wsPopup = 0x8000_0000
+
+	tests := []struct {
+		name string
+		data []byte
+		want Dialog
+	}{
+		{
+			name: "DLGTEMPLATE",
+			data: pad4(testbuf.Words(
+				uint32(wsPopup|_DS_SETFONT), uint32(0x80), // style, dwExtendedStyle
+				uint16(0), uint16(1), uint16(2), uint16(150), uint16(50),
+				uint16(0xffff), uint16(200), // menu ordinal
+				uint16(0), // predefined class
+				"Old", uint16(8), "MS Shell Dlg",
+			)),
+			want: Dialog{
+				Style: wsPopup | _DS_SETFONT, ExStyle: 0x80,
+				X: 1, Y: 2, Cx: 150, Cy: 50,
+				Menu: ResIdInt(200), Title: "Old",
+				PointSize: 8, FaceName: "MS Shell Dlg",
+			},
+		},
+		{
+			name: "DLGTEMPLATEEX",
+			data: testbuf.Words(
+				uint16(1), uint16(0xffff), uint32(7), // dlgVer, signature, helpID
+				uint32(0x100), uint32(wsPopup|_DS_SETFONT), // exStyle, style
+				uint16(0), uint16(0xfffb), uint16(10), uint16(180), uint16(60), // x is -5
+				"MENU", "MyClass", "New",
+				uint16(9), uint16(700), []byte{1, 2}, "Segoe UI",
+			),
+			want: Dialog{
+				Extended: true, HelpId: 7,
+				Style: wsPopup | _DS_SETFONT, ExStyle: 0x100,
+				X: -5, Y: 10, Cx: 180, Cy: 60,
+				Menu: ResIdStr("MENU"), Class: ResIdStr("MyClass"), Title: "New",
+				PointSize: 9, Weight: 700, Italic: true, CharSet: 2, FaceName: "Segoe UI",
+			},
+		},
+		{
+			name: "without font",
+			data: testbuf.Words(uint32(wsPopup), uint32(0), uint16(0),
+				uint16(0), uint16(0), uint16(10), uint16(10), uint16(0), uint16(0), ""),
+			want: Dialog{Style: wsPopup, Cx: 10, Cy: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dlg, err := ParseDialog(tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*dlg, tt.want) {
+				t.Errorf("got %+v\nwant %+v", *dlg, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDialogControls(t *testing.T) {
+	const wsChild, wsVisible = 0x4000_0000, 0x1000_0000
+
+	// DLGTEMPLATE with two DLGITEMTEMPLATE; the first one doesn't end at a
+	// DWORD boundary, so the second one is preceded by padding.
+	old := pad4(testbuf.Words(
+		uint32(0), uint32(0), uint16(2),
+		uint16(0), uint16(0), uint16(100), uint16(100),
+		uint16(0), uint16(0), "D",
+	))
+	old = pad4(append(old, testbuf.Words(
+		uint32(wsChild|wsVisible), uint32(0),
+		uint16(5), uint16(6), uint16(40), uint16(12), uint16(1), // id is a WORD
+		uint16(0xffff), uint16(0x0080), // Button atom
+		"OK!",
+		uint16(4), []byte{0xaa, 0xbb}, // extra count includes itself
+	)...))
+	old = append(old, testbuf.Words(
+		uint32(wsChild), uint32(0),
+		uint16(0), uint16(0), uint16(32), uint16(32), uint16(0xffff),
+		uint16(0xffff), uint16(0x0082), // Static atom
+		uint16(0xffff), uint16(101), // icon resource
+		uint16(0),
+	)...)
+
+	dlg, err := ParseDialog(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []DialogControl{
+		{Style: wsChild | wsVisible, X: 5, Y: 6, Cx: 40, Cy: 12, Id: 1,
+			Class: ResIdInt(0x0080), Title: ResIdStr("OK!"), CreationData: []byte{0xaa, 0xbb}},
+		{Style: wsChild, Cx: 32, Cy: 32, Id: 0xffff,
+			Class: ResIdInt(0x0082), Title: ResIdInt(101)},
+	}
+	if !reflect.DeepEqual(dlg.Controls, want) {
+		t.Errorf("DLGTEMPLATE controls:\ngot  %+v\nwant %+v", dlg.Controls, want)
+	}
+
+	// DLGTEMPLATEEX with a DLGITEMTEMPLATEEX, whose id is a DWORD and whose
+	// extra count doesn't include itself.
+	ex := pad4(testbuf.Words(
+		uint16(1), uint16(0xffff), uint32(0), uint32(0), uint32(0), uint16(1),
+		uint16(0), uint16(0), uint16(100), uint16(100),
+		uint16(0), uint16(0), "E",
+	))
+	ex = append(ex, testbuf.Words(
+		uint32(3), uint32(0x200), uint32(wsChild), // helpID, exStyle, style
+		uint16(1), uint16(2), uint16(3), uint16(4), uint32(0x0001_0000),
+		"SysListView32", "",
+		uint16(2), []byte{0xcc, 0xdd},
+	)...)
+
+	dlg, err = ParseDialog(ex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []DialogControl{
+		{HelpId: 3, ExStyle: 0x200, Style: wsChild, X: 1, Y: 2, Cx: 3, Cy: 4, Id: 0x0001_0000,
+			Class: ResIdStr("SysListView32"), CreationData: []byte{0xcc, 0xdd}},
+	}
+	if !reflect.DeepEqual(dlg.Controls, want) {
+		t.Errorf("DLGTEMPLATEEX controls:\ngot  %+v\nwant %+v", dlg.Controls, want)
+	}
+}
+
+func TestParseDialogErrors(t *testing.T) {
+	valid := testbuf.Words(uint32(0), uint32(0), uint16(1), // one control, which is missing
+		uint16(0), uint16(0), uint16(10), uint16(10), uint16(0), uint16(0), "")
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"truncated header", valid[:10]},
+		{"unterminated title", valid[:len(valid)-1]},
+		{"missing control", valid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseDialog(tt.data); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestFileDialog(t *testing.T) {
+	data := testbuf.Words(uint32(0), uint32(0), uint16(0),
+		uint16(0), uint16(0), uint16(10), uint16(10), uint16(0), uint16(0), "Found")
+	f, err := NewFile(bytes.NewReader(makePe(makeRsrc([]_TestNode{
+		dirNode(ResIdInt(RT_DIALOG), dirNode(ResIdInt(400), resNode(1033, data))),
+	}), true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dlg, err := f.Dialog(ResIdInt(400)); err != nil || dlg.Title != "Found" {
+		t.Errorf("got %+v, %v", dlg, err)
+	}
+	if _, err := f.Dialog(ResIdInt(401)); err == nil {
+		t.Error("expected error for missing dialog")
+	}
+}
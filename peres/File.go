@@ -0,0 +1,298 @@
+// Package peres reads the resources of PE files – executables and DLLs –
+// without calling Windows APIs, so it works on any operating system.
+//
+// Since the win package builds only on Windows, this package declares its own
+// types: the version information, for example, is returned as a
+// peres.VersionInfo, not as a win.VersionInfo.
+package peres
+
+import (
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf16"
+)
+
+// Predefined resource types.
+const (
+	RT_CURSOR       uint16 = 1
+	RT_BITMAP       uint16 = 2
+	RT_ICON         uint16 = 3
+	RT_MENU         uint16 = 4
+	RT_DIALOG       uint16 = 5
+	RT_STRING       uint16 = 6
+	RT_ACCELERATOR  uint16 = 9
+	RT_RCDATA       uint16 = 10
+	RT_GROUP_CURSOR uint16 = 12
+	RT_GROUP_ICON   uint16 = 14
+	RT_VERSION      uint16 = 16
+	RT_MANIFEST     uint16 = 24
+)
+
+// Identifies a resource type or name, which can be either an integer or a
+// string.
+type ResId struct {
+	Id  uint16
+	Str string // If not empty, the identifier is a string, and Id is ignored.
+}
+
+// Creates a [ResId] with an integer value.
+func ResIdInt(id uint16) ResId { return ResId{Id: id} }
+
+// Creates a [ResId] with a string value.
+func ResIdStr(str string) ResId { return ResId{Str: str} }
+
+// Returns the identifier as a string, either the name or the number.
+func (r ResId) String() string {
+	if r.Str != "" {
+		return r.Str
+	}
+	return fmt.Sprintf("%d", r.Id)
+}
+
+// A single resource of the PE file.
+type Resource struct {
+	Type     ResId
+	Name     ResId
+	Lang     uint16
+	CodePage uint32
+	Data     []byte
+}
+
+// A PE file whose resources have been read.
+//
+// # Example
+//
+//	f, err := peres.Open("C:\\Windows\\notepad.exe")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer f.Close()
+//
+//	if vi, err := f.VersionInfo(); err == nil {
+//		println(vi.FileVersionStr())
+//	}
+type File struct {
+	closer    io.Closer
+	resources []Resource
+}
+
+// Opens the PE file and reads its resources.
+//
+// ⚠️ You must defer [File.Close].
+func Open(name string) (*File, error) {
+	fo, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := NewFile(fo)
+	if err != nil {
+		fo.Close()
+		return nil, err
+	}
+	f.closer = fo
+	return f, nil
+}
+
+// Reads the resources of the PE file from r. A PE file without resources is
+// not an error.
+func NewFile(r io.ReaderAt) (*File, error) {
+	pf, err := pe.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	var dir pe.DataDirectory
+	switch hdr := pf.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		if hdr.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_RESOURCE {
+			dir = hdr.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_RESOURCE]
+		}
+	case *pe.OptionalHeader64:
+		if hdr.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_RESOURCE {
+			dir = hdr.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_RESOURCE]
+		}
+	default:
+		return nil, errors.New("PE file has no optional header")
+	}
+
+	f := &File{}
+	if dir.VirtualAddress == 0 || dir.Size == 0 {
+		return f, nil // no resources
+	}
+
+	for _, sec := range pf.Sections {
+		if dir.VirtualAddress >= sec.VirtualAddress &&
+			dir.VirtualAddress < sec.VirtualAddress+sec.Size {
+			data, err := sec.Data()
+			if err != nil {
+				return nil, err
+			}
+			rd := _ResReader{
+				data:    data,
+				dirOff:  dir.VirtualAddress - sec.VirtualAddress,
+				baseRva: sec.VirtualAddress,
+			}
+			if f.resources, err = rd.readAll(); err != nil {
+				return nil, err
+			}
+			return f, nil
+		}
+	}
+	return nil, errors.New("resource directory is outside all sections")
+}
+
+// Closes the underlying file, if it was opened by [Open].
+func (f *File) Close() error {
+	if f.closer != nil {
+		err := f.closer.Close()
+		f.closer = nil
+		return err
+	}
+	return nil
+}
+
+// Returns the resource with the given type and name, in the first language
+// found, and true; or false if it doesn't exist.
+func (f *File) Find(typ, name ResId) (Resource, bool) {
+	for _, res := range f.resources {
+		if res.Type == typ && res.Name == name {
+			return res, true
+		}
+	}
+	return Resource{}, false
+}
+
+// Returns all the resources of the file, ordered as in the resource
+// directory.
+func (f *File) Resources() []Resource {
+	return f.resources
+}
+
+// Returns all the resources with the given type.
+func (f *File) ResourcesOfType(typ ResId) []Resource {
+	var ress []Resource
+	for _, res := range f.resources {
+		if res.Type == typ {
+			ress = append(ress, res)
+		}
+	}
+	return ress
+}
+
+// Walks the 3-level resource directory: type, name and language.
+type _ResReader struct {
+	data    []byte // Contents of the section.
+	dirOff  uint32 // Offset of the root directory within the section.
+	baseRva uint32 // RVA of the section.
+}
+
+func (rd *_ResReader) readAll() ([]Resource, error) {
+	var ress []Resource
+	err := rd.walkDir(0, 0, [3]ResId{}, func(path [3]ResId, dataEntryOff uint32) error {
+		entry, err := rd.slice(dataEntryOff, 16) // IMAGE_RESOURCE_DATA_ENTRY
+		if err != nil {
+			return err
+		}
+		rva := binary.LittleEndian.Uint32(entry[0:])
+		size := binary.LittleEndian.Uint32(entry[4:])
+		if rva < rd.baseRva {
+			return errors.New("resource data outside the section")
+		}
+		data, err := rd.slice(rva-rd.baseRva, size)
+		if err != nil {
+			return err
+		}
+		ress = append(ress, Resource{
+			Type:     path[0],
+			Name:     path[1],
+			Lang:     path[2].Id,
+			CodePage: binary.LittleEndian.Uint32(entry[8:]),
+			Data:     data,
+		})
+		return nil
+	})
+	return ress, err
+}
+
+func (rd *_ResReader) walkDir(off uint32, level int, path [3]ResId,
+	fun func(path [3]ResId, dataEntryOff uint32) error) error {
+
+	hdr, err := rd.slice(rd.dirOff+off, 16) // IMAGE_RESOURCE_DIRECTORY
+	if err != nil {
+		return err
+	}
+	numEntries := uint32(binary.LittleEndian.Uint16(hdr[12:])) +
+		uint32(binary.LittleEndian.Uint16(hdr[14:]))
+
+	for i := uint32(0); i < numEntries; i++ {
+		entry, err := rd.slice(rd.dirOff+off+16+8*i, 8) // IMAGE_RESOURCE_DIRECTORY_ENTRY
+		if err != nil {
+			return err
+		}
+		nameField := binary.LittleEndian.Uint32(entry[0:])
+		dataField := binary.LittleEndian.Uint32(entry[4:])
+
+		if nameField&0x8000_0000 != 0 {
+			str, err := rd.readDirString(nameField &^ 0x8000_0000)
+			if err != nil {
+				return err
+			}
+			path[level] = ResIdStr(str)
+		} else {
+			path[level] = ResIdInt(uint16(nameField))
+		}
+
+		if dataField&0x8000_0000 != 0 {
+			if level == 2 {
+				return errors.New("resource directory is too deep")
+			}
+			if err := rd.walkDir(dataField&^0x8000_0000, level+1, path, fun); err != nil {
+				return err
+			}
+		} else if level == 2 {
+			if err := fun(path, rd.dirOff+dataField); err != nil {
+				return err
+			}
+		} else {
+			return errors.New("resource data entry at wrong directory level")
+		}
+	}
+	return nil
+}
+
+// Reads an IMAGE_RESOURCE_DIR_STRING_U, whose offset is relative to the root
+// directory.
+func (rd *_ResReader) readDirString(off uint32) (string, error) {
+	lenBuf, err := rd.slice(rd.dirOff+off, 2)
+	if err != nil {
+		return "", err
+	}
+	numChars := uint32(binary.LittleEndian.Uint16(lenBuf))
+	chars, err := rd.slice(rd.dirOff+off+2, numChars*2)
+	if err != nil {
+		return "", err
+	}
+	return decodeUtf16(chars), nil
+}
+
+// Returns a bounds-checked slice of the section.
+func (rd *_ResReader) slice(off, size uint32) ([]byte, error) {
+	if uint64(off)+uint64(size) > uint64(len(rd.data)) {
+		return nil, errors.New("resource directory is truncated")
+	}
+	return rd.data[off : off+size], nil
+}
+
+// Decodes UTF-16 characters, without a terminating null.
+func decodeUtf16(b []byte) string {
+	chars := make([]uint16, len(b)/2)
+	for i := range chars {
+		chars[i] = binary.LittleEndian.Uint16(b[2*i:])
+	}
+	return string(utf16.Decode(chars))
+}
@@ -0,0 +1,150 @@
+package peres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// Resources with named and numbered types and names, in two languages.
+func testTypes() []_TestNode {
+	return []_TestNode{
+		dirNode(ResIdStr("PNG"),
+			dirNode(ResIdStr("LOGO"), resNode(1033, []byte("logo"))),
+			dirNode(ResIdInt(7), resNode(1033, []byte("seven")))),
+		dirNode(ResIdInt(RT_RCDATA),
+			dirNode(ResIdStr("Config"), resNode(1046, []byte("pt")), resNode(1033, []byte("en")))),
+		dirNode(ResIdInt(RT_MANIFEST),
+			dirNode(ResIdInt(1), resNode(1033, []byte("<assembly/>")))),
+	}
+}
+
+func TestNewFile(t *testing.T) {
+	for _, pe32plus := range []bool{false, true} {
+		name := map[bool]string{false: "PE32", true: "PE32+"}[pe32plus]
+		t.Run(name, func(t *testing.T) {
+			f, err := NewFile(bytes.NewReader(makePe(makeRsrc(testTypes()), pe32plus)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := []Resource{
+				{ResIdStr("PNG"), ResIdStr("LOGO"), 1033, 1252, []byte("logo")},
+				{ResIdStr("PNG"), ResIdInt(7), 1033, 1252, []byte("seven")},
+				{ResIdInt(RT_RCDATA), ResIdStr("Config"), 1046, 1252, []byte("pt")},
+				{ResIdInt(RT_RCDATA), ResIdStr("Config"), 1033, 1252, []byte("en")},
+				{ResIdInt(RT_MANIFEST), ResIdInt(1), 1033, 1252, []byte("<assembly/>")},
+			}
+			if got := f.Resources(); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v\nwant %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestNewFileWithoutResources(t *testing.T) {
+	f, err := NewFile(bytes.NewReader(makePe(nil, true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(f.Resources()); n != 0 {
+		t.Errorf("got %d resources, want none", n)
+	}
+	if _, err := f.Manifest(); err == nil {
+		t.Error("expected error for missing manifest")
+	}
+}
+
+func TestNewFileErrors(t *testing.T) {
+	truncated := makePe(makeRsrc(testTypes())[:40], true) // directory entries cut in the middle
+
+	rsrc := makeRsrc(testTypes())
+	entry := bytes.Index(rsrc, []byte("<assembly/>")) - 16 // IMAGE_RESOURCE_DATA_ENTRY before the data
+	binary.LittleEndian.PutUint32(rsrc[entry:], 0x10)      // RVA before the section
+	badDataRva := makePe(rsrc, true)
+
+	tests := []struct {
+		name string
+		img  []byte
+	}{
+		{"not a PE", []byte("not a PE file at all, just some text")},
+		{"truncated directory", truncated},
+		{"data outside the section", badDataRva},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewFile(bytes.NewReader(tt.img)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	f, err := NewFile(bytes.NewReader(makePe(makeRsrc(testTypes()), true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		typ, name ResId
+		wantData  string // empty if not found
+	}{
+		{ResIdStr("PNG"), ResIdStr("LOGO"), "logo"},
+		{ResIdStr("PNG"), ResIdInt(7), "seven"},
+		{ResIdInt(RT_RCDATA), ResIdStr("Config"), "pt"}, // first language
+		{ResIdInt(RT_MANIFEST), ResIdInt(1), "<assembly/>"},
+		{ResIdStr("PNG"), ResIdStr("logo"), ""}, // names are case-sensitive
+		{ResIdStr("PNG"), ResIdInt(8), ""},
+		{ResIdInt(RT_RCDATA), ResIdInt(1), ""},
+		{ResIdInt(RT_ICON), ResIdInt(1), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ.String()+"/"+tt.name.String(), func(t *testing.T) {
+			res, ok := f.Find(tt.typ, tt.name)
+			if ok != (tt.wantData != "") || string(res.Data) != tt.wantData {
+				t.Errorf("got %q, %v; want %q", res.Data, ok, tt.wantData)
+			}
+		})
+	}
+
+	if n := len(f.ResourcesOfType(ResIdStr("PNG"))); n != 2 {
+		t.Errorf("ResourcesOfType: got %d resources, want 2", n)
+	}
+	if n := len(f.ResourcesOfType(ResIdInt(RT_ICON))); n != 0 {
+		t.Errorf("ResourcesOfType: got %d resources, want none", n)
+	}
+	if manifest, err := f.Manifest(); err != nil || manifest != "<assembly/>" {
+		t.Errorf("Manifest: got %q, %v", manifest, err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.exe")
+	if err := os.WriteFile(path, makePe(makeRsrc(testTypes()), false), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(f.Resources()); n != 5 {
+		t.Errorf("got %d resources, want 5", n)
+	}
+	if err := f.Close(); err != nil {
+		t.Error(err)
+	}
+	if err := f.Close(); err != nil { // second call does nothing
+		t.Error(err)
+	}
+
+	if _, err := Open(filepath.Join(t.TempDir(), "missing.exe")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
@@ -0,0 +1,185 @@
+package peres
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Version information of a PE file, read from its RT_VERSION resource.
+type VersionInfo struct {
+	FileVersion    [4]uint16 // Major, minor, patch and build.
+	ProductVersion [4]uint16
+	FileFlagsMask  uint32
+	FileFlags      uint32
+	FileOS         uint32
+	FileType       uint32
+	FileSubtype    uint32
+	FileDate       uint64
+	StringTables   []VersionStringTable
+	Translations   []VersionTranslation
+}
+
+// Block of version strings, like CompanyName and FileDescription, in a given
+// language and code page.
+type VersionStringTable struct {
+	LangId   uint16
+	CodePage uint16
+	Strings  map[string]string
+}
+
+// Language and code page listed in the VarFileInfo of the version
+// information.
+type VersionTranslation struct {
+	LangId   uint16
+	CodePage uint16
+}
+
+// Reads and decodes the RT_VERSION resource of the file.
+func (f *File) VersionInfo() (*VersionInfo, error) {
+	res, ok := f.Find(ResIdInt(RT_VERSION), ResIdInt(1)) // VS_VERSION_INFO
+	if !ok {
+		ress := f.ResourcesOfType(ResIdInt(RT_VERSION))
+		if len(ress) == 0 {
+			return nil, errors.New("no version information")
+		}
+		res = ress[0]
+	}
+	return ParseVersionInfo(res.Data)
+}
+
+// Decodes the contents of an RT_VERSION resource, a VS_VERSIONINFO block.
+func ParseVersionInfo(data []byte) (*VersionInfo, error) {
+	root, err := parseVerNode(data)
+	if err != nil {
+		return nil, err
+	}
+	if root.key != "VS_VERSION_INFO" {
+		return nil, errors.New("invalid version information")
+	}
+
+	vi := &VersionInfo{}
+	if len(root.value) >= 52 { // VS_FIXEDFILEINFO
+		r := _Reader{data: root.value}
+		if r.u32() != 0xfeef_04bd {
+			return nil, errors.New("invalid VS_FIXEDFILEINFO signature")
+		}
+		r.u32() // dwStrucVersion
+		fileMs, fileLs := r.u32(), r.u32()
+		prodMs, prodLs := r.u32(), r.u32()
+		vi.FileVersion = [4]uint16{uint16(fileMs >> 16), uint16(fileMs), uint16(fileLs >> 16), uint16(fileLs)}
+		vi.ProductVersion = [4]uint16{uint16(prodMs >> 16), uint16(prodMs), uint16(prodLs >> 16), uint16(prodLs)}
+		vi.FileFlagsMask = r.u32()
+		vi.FileFlags = r.u32()
+		vi.FileOS = r.u32()
+		vi.FileType = r.u32()
+		vi.FileSubtype = r.u32()
+		vi.FileDate = uint64(r.u32())<<32 | uint64(r.u32())
+	}
+
+	for _, child := range root.children {
+		switch child.key {
+		case "StringFileInfo":
+			for _, table := range child.children {
+				var langId, codePage uint16
+				fmt.Sscanf(table.key, "%04x%04x", &langId, &codePage)
+				strs := make(map[string]string, len(table.children))
+				for _, str := range table.children {
+					strs[str.key] = str.text()
+				}
+				vi.StringTables = append(vi.StringTables,
+					VersionStringTable{langId, codePage, strs})
+			}
+		case "VarFileInfo":
+			for _, v := range child.children {
+				if v.key == "Translation" {
+					r := _Reader{data: v.value}
+					for len(r.data)-r.pos >= 4 {
+						vi.Translations = append(vi.Translations,
+							VersionTranslation{r.u16(), r.u16()})
+					}
+				}
+			}
+		}
+	}
+	return vi, nil
+}
+
+// Returns the file version formatted like "1.2.3.4".
+func (vi *VersionInfo) FileVersionStr() string {
+	return fmt.Sprintf("%d.%d.%d.%d",
+		vi.FileVersion[0], vi.FileVersion[1], vi.FileVersion[2], vi.FileVersion[3])
+}
+
+// Returns the product version formatted like "1.2.3.4".
+func (vi *VersionInfo) ProductVersionStr() string {
+	return fmt.Sprintf("%d.%d.%d.%d",
+		vi.ProductVersion[0], vi.ProductVersion[1], vi.ProductVersion[2], vi.ProductVersion[3])
+}
+
+// Returns the version string with the given key, like "CompanyName", searching
+// the string tables in order, and true; or false if it doesn't exist.
+func (vi *VersionInfo) String(key string) (string, bool) {
+	for _, table := range vi.StringTables {
+		if str, ok := table.Strings[key]; ok {
+			return str, true
+		}
+	}
+	return "", false
+}
+
+// A block of the version information, with its children.
+type _VerNode struct {
+	key      string
+	wType    uint16 // 1 for text values.
+	value    []byte
+	children []_VerNode
+}
+
+// Parses a block: wLength, wValueLength, wType, szKey, the value and the
+// children, all DWORD-aligned.
+func parseVerNode(data []byte) (_VerNode, error) {
+	r := _Reader{data: data}
+	length := int(r.u16())
+	valueLen := int(r.u16())
+	node := _VerNode{wType: r.u16()}
+	if r.err != nil || length < 6 || length > len(data) {
+		return _VerNode{}, errTruncated
+	}
+	r.data = data[:length] // children end within the block
+	node.key = r.sz()
+	r.align(4)
+
+	if node.wType == 1 {
+		valueLen *= 2 // given in WORDs
+	}
+	if valueLen > len(r.data)-r.pos {
+		valueLen = len(r.data) - r.pos
+	}
+	node.value = r.bytes(valueLen)
+	r.align(4)
+	if r.err != nil {
+		return _VerNode{}, r.err
+	}
+
+	for r.pos < len(r.data) {
+		child, err := parseVerNode(r.data[r.pos:])
+		if err != nil {
+			return _VerNode{}, err
+		}
+		node.children = append(node.children, child)
+		r.pos += int(binary.LittleEndian.Uint16(r.data[r.pos:])) // wLength
+		r.align(4)
+	}
+	return node, nil
+}
+
+// Returns the value as text, until the first null.
+func (n *_VerNode) text() string {
+	str := decodeUtf16(n.value)
+	if idx := strings.IndexByte(str, 0); idx >= 0 {
+		str = str[:idx]
+	}
+	return str
+}
@@ -0,0 +1,169 @@
+package peres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+// Builds a block of the version information; for text values, valueLen is
+// given in WORDs.
+func verNode(key string, wType uint16, value []byte, valueLen uint16, children ...[]byte) []byte {
+	buf := pad4(testbuf.Words(uint16(0), valueLen, wType, key)) // wLength is written below
+	buf = append(buf, value...)
+	for _, child := range children {
+		buf = append(pad4(buf), child...)
+	}
+	binary.LittleEndian.PutUint16(buf[0:], uint16(len(buf)))
+	return buf
+}
+
+// Builds a string of a string table.
+func verStr(key, val string) []byte {
+	value := testbuf.Words(val)
+	return verNode(key, 1, value, uint16(len(value)/2))
+}
+
+// Builds a VS_FIXEDFILEINFO with the given versions.
+func fixedFileInfo(fileMs, fileLs, prodMs, prodLs uint32) []byte {
+	return testbuf.Words(
+		uint32(0xfeef_04bd), uint32(0x0001_0000),
+		fileMs, fileLs, prodMs, prodLs,
+		uint32(0x3f), uint32(0x2), // dwFileFlagsMask, dwFileFlags
+		uint32(0x0004_0004), uint32(2), uint32(0), // dwFileOS, dwFileType, dwFileSubtype
+		uint32(0x1), uint32(0x2), // dwFileDateMS, dwFileDateLS
+	)
+}
+
+func TestParseVersionInfo(t *testing.T) {
+	ffi := fixedFileInfo(0x0001_0002, 0x0003_0004, 0x0005_0000, 0x0000_0006)
+	data := verNode("VS_VERSION_INFO", 0, ffi, uint16(len(ffi)),
+		verNode("StringFileInfo", 1, nil, 0,
+			verNode("041604b0", 1, nil, 0,
+				verStr("CompanyName", "Acme"),
+				verStr("ProductName", "Notas")),
+			verNode("040904b0", 1, nil, 0,
+				verStr("ProductName", "Notes"),
+				verStr("Comments", ""))),
+		verNode("VarFileInfo", 1, nil, 0,
+			verNode("Translation", 0, testbuf.Words(uint16(0x0416), uint16(1200), uint16(0x0409), uint16(1200)), 8)),
+	)
+
+	vi, err := ParseVersionInfo(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := VersionInfo{
+		FileVersion:    [4]uint16{1, 2, 3, 4},
+		ProductVersion: [4]uint16{5, 0, 0, 6},
+		FileFlagsMask:  0x3f,
+		FileFlags:      0x2,
+		FileOS:         0x0004_0004,
+		FileType:       2,
+		FileDate:       0x1_0000_0002,
+		StringTables: []VersionStringTable{
+			{0x0416, 0x04b0, map[string]string{"CompanyName": "Acme", "ProductName": "Notas"}},
+			{0x0409, 0x04b0, map[string]string{"ProductName": "Notes", "Comments": ""}},
+		},
+		Translations: []VersionTranslation{{0x0416, 1200}, {0x0409, 1200}},
+	}
+	if !reflect.DeepEqual(*vi, want) {
+		t.Errorf("got %+v\nwant %+v", *vi, want)
+	}
+
+	if s := vi.FileVersionStr(); s != "1.2.3.4" {
+		t.Errorf("FileVersionStr: got %q", s)
+	}
+	if s := vi.ProductVersionStr(); s != "5.0.0.6" {
+		t.Errorf("ProductVersionStr: got %q", s)
+	}
+
+	strTests := []struct {
+		key, want string
+		found     bool
+	}{
+		{"CompanyName", "Acme", true},
+		{"ProductName", "Notas", true}, // first table wins
+		{"Comments", "", true},
+		{"LegalCopyright", "", false},
+	}
+	for _, tt := range strTests {
+		if got, ok := vi.String(tt.key); got != tt.want || ok != tt.found {
+			t.Errorf("String(%q): got %q, %v; want %q, %v", tt.key, got, ok, tt.want, tt.found)
+		}
+	}
+}
+
+func TestParseVersionInfoWithoutFixedInfo(t *testing.T) {
+	vi, err := ParseVersionInfo(verNode("VS_VERSION_INFO", 0, nil, 0,
+		verNode("StringFileInfo", 1, nil, 0,
+			verNode("040904b0", 1, nil, 0, verStr("FileVersion", "9.9")))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vi.FileVersionStr() != "0.0.0.0" || len(vi.Translations) != 0 {
+		t.Errorf("got %+v", *vi)
+	}
+	if s, _ := vi.String("FileVersion"); s != "9.9" {
+		t.Errorf("FileVersion string: got %q", s)
+	}
+}
+
+func TestParseVersionInfoErrors(t *testing.T) {
+	ffi := fixedFileInfo(0, 0, 0, 0)
+	valid := verNode("VS_VERSION_INFO", 0, ffi, uint16(len(ffi)))
+
+	badSignature := append([]byte(nil), valid...)
+	sigOff := bytes.Index(badSignature, ffi)
+	badSignature[sigOff] = 0
+
+	badChild := verNode("VS_VERSION_INFO", 0, nil, 0, testbuf.Words(uint16(2), uint16(0), uint16(0)))
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"wrong key", verNode("VS_VERSION", 0, ffi, uint16(len(ffi)))},
+		{"length beyond the end", valid[:len(valid)-4]},
+		{"bad signature", badSignature},
+		{"child too short", badChild},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseVersionInfo(tt.data); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestFileVersionInfo(t *testing.T) {
+	ffi := fixedFileInfo(0x0002_0000, 0, 0x0002_0000, 0)
+	data := verNode("VS_VERSION_INFO", 0, ffi, uint16(len(ffi)))
+
+	for _, name := range []ResId{ResIdInt(1), ResIdInt(7)} { // the ID 1 is preferred, but not required
+		f, err := NewFile(bytes.NewReader(makePe(makeRsrc([]_TestNode{
+			dirNode(ResIdInt(RT_VERSION), dirNode(name, resNode(1033, data))),
+		}), true)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vi, err := f.VersionInfo(); err != nil || vi.FileVersionStr() != "2.0.0.0" {
+			t.Errorf("resource %s: got %+v, %v", name, vi, err)
+		}
+	}
+
+	f, err := NewFile(bytes.NewReader(makePe(nil, true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.VersionInfo(); err == nil {
+		t.Error("expected error for missing version information")
+	}
+}
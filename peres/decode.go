@@ -0,0 +1,116 @@
+package peres
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Reads the RT_GROUP_ICON resource with the given ID, and the RT_ICON images
+// it references, returning the contents of an equivalent .ico file.
+func (f *File) Icon(id ResId) ([]byte, error) {
+	group, ok := f.Find(ResIdInt(RT_GROUP_ICON), id)
+	if !ok {
+		return nil, fmt.Errorf("icon group %s not found", id)
+	}
+
+	r := _Reader{data: group.Data}
+	r.u16() // idReserved
+	if r.u16() != 1 {
+		return nil, errors.New("invalid icon group")
+	}
+	count := int(r.u16())
+
+	type _Img struct {
+		entry []byte // GRPICONDIRENTRY without nID
+		data  []byte
+	}
+	imgs := make([]_Img, 0, count)
+	for i := 0; i < count; i++ {
+		entry := r.bytes(12)
+		imgId := r.u16()
+		if r.err != nil {
+			return nil, fmt.Errorf("invalid icon group: %w", r.err)
+		}
+		img, ok := f.Find(ResIdInt(RT_ICON), ResIdInt(imgId))
+		if !ok {
+			return nil, fmt.Errorf("icon image %d not found", imgId)
+		}
+		imgs = append(imgs, _Img{entry, img.Data})
+	}
+
+	buf := make([]byte, 6, 6+16*count) // ICONDIR
+	binary.LittleEndian.PutUint16(buf[2:], 1)
+	binary.LittleEndian.PutUint16(buf[4:], uint16(count))
+	offset := 6 + 16*count
+	for _, img := range imgs { // ICONDIRENTRY: the image offset replaces nID
+		buf = append(buf, img.entry[:8]...)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(img.data)))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(offset))
+		offset += len(img.data)
+	}
+	for _, img := range imgs {
+		buf = append(buf, img.data...)
+	}
+	return buf, nil
+}
+
+// Returns the contents of the RT_MANIFEST resource.
+func (f *File) Manifest() (string, error) {
+	ress := f.ResourcesOfType(ResIdInt(RT_MANIFEST))
+	if len(ress) == 0 {
+		return "", errors.New("no manifest")
+	}
+	return string(ress[0].Data), nil
+}
+
+// Returns the string with the given ID from the RT_STRING tables, and true; or
+// false if it doesn't exist.
+func (f *File) String(id uint16) (string, bool) {
+	res, ok := f.Find(ResIdInt(RT_STRING), ResIdInt(id/16+1))
+	if !ok {
+		return "", false
+	}
+	strs, err := parseStringBlock(res.Data)
+	if err != nil {
+		return "", false
+	}
+	str := strs[id%16]
+	return str, str != ""
+}
+
+// Returns all the non-empty strings of the RT_STRING tables, keyed by ID. If
+// a string exists in more than one language, the first one is returned.
+func (f *File) Strings() (map[uint16]string, error) {
+	strs := make(map[uint16]string)
+	for _, res := range f.ResourcesOfType(ResIdInt(RT_STRING)) {
+		if res.Name.Str != "" || res.Name.Id == 0 {
+			return nil, fmt.Errorf("invalid string table %s", res.Name)
+		}
+		block, err := parseStringBlock(res.Data)
+		if err != nil {
+			return nil, err
+		}
+		for i, str := range block {
+			id := (res.Name.Id-1)*16 + uint16(i)
+			if _, has := strs[id]; !has && str != "" {
+				strs[id] = str
+			}
+		}
+	}
+	return strs, nil
+}
+
+// Decodes an RT_STRING block of 16 strings, each one prefixed by its length.
+func parseStringBlock(data []byte) ([16]string, error) {
+	var strs [16]string
+	r := _Reader{data: data}
+	for i := range strs {
+		numChars := int(r.u16())
+		strs[i] = decodeUtf16(r.bytes(numChars * 2))
+	}
+	if r.err != nil {
+		return strs, fmt.Errorf("invalid string table: %w", r.err)
+	}
+	return strs, nil
+}
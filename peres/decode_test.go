@@ -0,0 +1,191 @@
+package peres
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+// Builds a GRPICONDIR whose entries reference the given RT_ICON IDs, each one
+// with the size of the image; the width and height are the index plus 16.
+func makeIconGroup(imgIds []uint16, imgs [][]byte) []byte {
+	group := testbuf.Words(uint16(0), uint16(1), uint16(len(imgIds)))
+	for i, id := range imgIds {
+		group = append(group, byte(16+i), byte(16+i), 0, 0)
+		group = append(group, testbuf.Words(uint16(1), uint16(32), uint32(len(imgs[i])), id)...)
+	}
+	return group
+}
+
+func TestIcon(t *testing.T) {
+	img1, img2 := []byte("small image"), []byte("big image")
+	f, err := NewFile(bytes.NewReader(makePe(makeRsrc([]_TestNode{
+		dirNode(ResIdInt(RT_ICON),
+			dirNode(ResIdInt(1), resNode(1033, img1)),
+			dirNode(ResIdInt(2), resNode(1033, img2))),
+		dirNode(ResIdInt(RT_GROUP_ICON),
+			dirNode(ResIdStr("MAINICON"), resNode(1033, makeIconGroup([]uint16{1, 2}, [][]byte{img1, img2}))),
+			dirNode(ResIdInt(101), resNode(1033, makeIconGroup([]uint16{2}, [][]byte{img2}))),
+			dirNode(ResIdInt(102), resNode(1033, makeIconGroup([]uint16{3}, [][]byte{img1}))),
+			dirNode(ResIdInt(103), resNode(1033, []byte{0, 0, 2, 0, 1, 0})), // cursor group
+			dirNode(ResIdInt(104), resNode(1033, makeIconGroup([]uint16{1, 2}, [][]byte{img1, img2})[:20]))),
+	}), true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ico, err := f.Icon(ResIdStr("MAINICON"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantIco := testbuf.Words(
+		uint16(0), uint16(1), uint16(2), // ICONDIR
+		[]byte{16, 16, 0, 0}, uint16(1), uint16(32), uint32(len(img1)), uint32(6+16*2),
+		[]byte{17, 17, 0, 0}, uint16(1), uint16(32), uint32(len(img2)), uint32(6+16*2+len(img1)),
+		img1, img2,
+	)
+	if !bytes.Equal(ico, wantIco) {
+		t.Errorf("got % x\nwant % x", ico, wantIco)
+	}
+
+	ico, err = f.Icon(ResIdInt(101))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantIco = testbuf.Words(uint16(0), uint16(1), uint16(1),
+		[]byte{16, 16, 0, 0}, uint16(1), uint16(32), uint32(len(img2)), uint32(6+16), img2)
+	if !bytes.Equal(ico, wantIco) {
+		t.Errorf("got % x\nwant % x", ico, wantIco)
+	}
+
+	for _, id := range []ResId{
+		ResIdInt(102), // missing image
+		ResIdInt(103), // not an icon group
+		ResIdInt(104), // truncated group
+		ResIdInt(999), // missing group
+	} {
+		if _, err := f.Icon(id); err == nil {
+			t.Errorf("icon %s: expected error", id)
+		}
+	}
+}
+
+func TestParseStringBlock(t *testing.T) {
+	empty := make([]byte, 2*16)
+
+	full := []byte(nil)
+	var wantFull [16]string
+	for i := range wantFull {
+		wantFull[i] = string(rune('a' + i))
+		full = append(full, testbuf.Words(uint16(1), uint16('a'+i))...)
+	}
+
+	tests := []struct {
+		name  string
+		data  []byte
+		want  [16]string
+		fails bool
+	}{
+		{name: "empty", data: empty},
+		{name: "full", data: full, want: wantFull},
+		{
+			name: "sparse",
+			data: testbuf.Words(uint16(2), uint16('H'), uint16('i'), make([]byte, 2*14), uint16(3), []byte("O\x00l\x00\xe1\x00")),
+			want: [16]string{0: "Hi", 15: "Olá"},
+		},
+		{name: "missing strings", data: empty[:30], fails: true},
+		{name: "length beyond the end", data: testbuf.Words(uint16(5), uint16('x')), fails: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStringBlock(tt.data)
+			if tt.fails {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrings(t *testing.T) {
+	block := func(strs map[int]string) []byte {
+		var buf []byte
+		for i := 0; i < 16; i++ {
+			chars := testbuf.Words(strs[i])
+			buf = append(buf, testbuf.Words(uint16(len(chars)/2-1), chars[:len(chars)-2])...)
+		}
+		return buf
+	}
+
+	f, err := NewFile(bytes.NewReader(makePe(makeRsrc([]_TestNode{
+		dirNode(ResIdInt(RT_STRING),
+			dirNode(ResIdInt(1), resNode(1046, block(map[int]string{0: "Zero", 5: "Cinco"})),
+				resNode(1033, block(map[int]string{0: "Zero EN", 6: "Six"}))),
+			dirNode(ResIdInt(63), resNode(1033, block(map[int]string{2: "Thousand"})))), // IDs 992 to 1007
+	}), true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	strs, err := f.Strings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[uint16]string{0: "Zero", 5: "Cinco", 6: "Six", 994: "Thousand"} // first language wins
+	if !reflect.DeepEqual(strs, want) {
+		t.Errorf("got %v, want %v", strs, want)
+	}
+
+	tests := []struct {
+		id   uint16
+		want string // empty if not found
+	}{
+		{0, "Zero"},
+		{5, "Cinco"},
+		{6, ""}, // only the first language is searched
+		{994, "Thousand"},
+		{995, ""},
+		{16, ""},
+	}
+	for _, tt := range tests {
+		if got, ok := f.String(tt.id); got != tt.want || ok != (tt.want != "") {
+			t.Errorf("String(%d): got %q, %v; want %q", tt.id, got, ok, tt.want)
+		}
+	}
+}
+
+func TestStringsErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		table _TestNode
+	}{
+		{"named block", dirNode(ResIdStr("STRS"), resNode(1033, make([]byte, 32)))},
+		{"block zero", dirNode(ResIdInt(0), resNode(1033, make([]byte, 32)))},
+		{"truncated block", dirNode(ResIdInt(1), resNode(1033, make([]byte, 10)))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFile(bytes.NewReader(makePe(makeRsrc([]_TestNode{
+				dirNode(ResIdInt(RT_STRING), tt.table),
+			}), true)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := f.Strings(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
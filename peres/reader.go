@@ -0,0 +1,96 @@
+package peres
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var errTruncated = errors.New("resource data is truncated")
+
+// Sequential reader of resource data; the first error is kept, and the
+// subsequent reads return zero values.
+type _Reader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *_Reader) need(n int) bool {
+	if r.err == nil && r.pos+n > len(r.data) {
+		r.err = errTruncated
+	}
+	return r.err == nil
+}
+
+func (r *_Reader) u8() uint8 {
+	if !r.need(1) {
+		return 0
+	}
+	r.pos++
+	return r.data[r.pos-1]
+}
+
+func (r *_Reader) u16() uint16 {
+	if !r.need(2) {
+		return 0
+	}
+	r.pos += 2
+	return binary.LittleEndian.Uint16(r.data[r.pos-2:])
+}
+
+func (r *_Reader) u32() uint32 {
+	if !r.need(4) {
+		return 0
+	}
+	r.pos += 4
+	return binary.LittleEndian.Uint32(r.data[r.pos-4:])
+}
+
+func (r *_Reader) bytes(n int) []byte {
+	if n < 0 || !r.need(n) {
+		return nil
+	}
+	r.pos += n
+	return r.data[r.pos-n : r.pos]
+}
+
+// Reads a null-terminated UTF-16 string.
+func (r *_Reader) sz() string {
+	start := r.pos
+	for {
+		ch := r.u16()
+		if r.err != nil {
+			return "" // unterminated
+		}
+		if ch == 0 {
+			return decodeUtf16(r.data[start : r.pos-2])
+		}
+	}
+}
+
+// Reads an sz_Or_Ord field: either 0x0000 for none, 0xffff followed by an
+// ordinal, or a null-terminated UTF-16 string.
+func (r *_Reader) szOrOrd() ResId {
+	if !r.need(2) {
+		return ResId{}
+	}
+	switch binary.LittleEndian.Uint16(r.data[r.pos:]) {
+	case 0x0000:
+		r.pos += 2
+		return ResId{}
+	case 0xffff:
+		r.pos += 2
+		return ResIdInt(r.u16())
+	default:
+		return ResIdStr(r.sz())
+	}
+}
+
+// Skips bytes until the position is a multiple of n, relative to the start of
+// the data. The padding may be missing at the end of the data.
+func (r *_Reader) align(n int) {
+	r.pos += (n - r.pos%n) % n
+	if r.pos > len(r.data) {
+		r.pos = len(r.data)
+	}
+}
@@ -0,0 +1,146 @@
+package peres
+
+import (
+	"encoding/binary"
+	"unicode/utf16"
+
+	"github.com/rodrigocfd/windigo/internal/testbuf"
+)
+
+// Appends zeros until the length is a multiple of 4.
+func pad4(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
+
+const _TEST_SEC_RVA = 0x2000 // RVA of the .rsrc section in the images built by makePe
+
+// Node of the resource directory built by makePe: either a directory, with
+// children, or a resource, with data.
+type _TestNode struct {
+	id       ResId
+	children []_TestNode
+	data     []byte
+}
+
+// Lays out a .rsrc section with the given type directories, which must list
+// the named entries first, as required by the format.
+func makeRsrc(types []_TestNode) []byte {
+	var buf []byte
+	type _Fixup struct {
+		at   int // offset of the field to be patched
+		node *_TestNode
+	}
+	var strFixups, dataFixups []_Fixup
+
+	var writeDir func(children []_TestNode) int
+	writeDir = func(children []_TestNode) int {
+		off := len(buf)
+		var numNamed, numIds uint16
+		for _, child := range children {
+			if child.id.Str != "" {
+				numNamed++
+			} else {
+				numIds++
+			}
+		}
+		buf = append(buf, make([]byte, 12)...) // Characteristics, TimeDateStamp, versions
+		buf = append(buf, testbuf.Words(numNamed, numIds)...)
+		entriesOff := len(buf)
+		buf = append(buf, make([]byte, 8*len(children))...)
+
+		for i := range children {
+			child := &children[i]
+			entry := entriesOff + 8*i
+			if child.id.Str != "" {
+				strFixups = append(strFixups, _Fixup{entry, child})
+			} else {
+				binary.LittleEndian.PutUint32(buf[entry:], uint32(child.id.Id))
+			}
+			if child.children != nil {
+				subOff := writeDir(child.children)
+				binary.LittleEndian.PutUint32(buf[entry+4:], 0x8000_0000|uint32(subOff))
+			} else {
+				dataFixups = append(dataFixups, _Fixup{entry + 4, child})
+			}
+		}
+		return off
+	}
+	writeDir(types)
+
+	for _, fix := range strFixups { // IMAGE_RESOURCE_DIR_STRING_U
+		binary.LittleEndian.PutUint32(buf[fix.at:], 0x8000_0000|uint32(len(buf)))
+		chars := utf16.Encode([]rune(fix.node.id.Str))
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(len(chars)))
+		for _, ch := range chars {
+			buf = binary.LittleEndian.AppendUint16(buf, ch)
+		}
+	}
+	buf = pad4(buf)
+
+	for _, fix := range dataFixups { // IMAGE_RESOURCE_DATA_ENTRY, then the data
+		binary.LittleEndian.PutUint32(buf[fix.at:], uint32(len(buf)))
+		dataOff := len(buf) + 16
+		buf = append(buf, testbuf.Words(uint32(_TEST_SEC_RVA+dataOff), uint32(len(fix.node.data)),
+			uint32(1252), uint32(0))...) // CodePage, Reserved
+		buf = pad4(append(buf, fix.node.data...))
+	}
+	return buf
+}
+
+// Wraps the .rsrc section into a minimal PE image, either PE32 or PE32+. If
+// rsrc is nil, the image has no resources.
+func makePe(rsrc []byte, pe32plus bool) []byte {
+	const lfanew, secHdrSize, rawOff = 64, 40, 0x200
+	optHdrSize, numDirsOff, magic := 224, 92, uint16(0x010b)
+	if pe32plus {
+		optHdrSize, numDirsOff, magic = 240, 108, 0x020b
+	}
+
+	img := make([]byte, rawOff+len(rsrc))
+	copy(img, "MZ")
+	binary.LittleEndian.PutUint32(img[0x3c:], lfanew)
+	copy(img[lfanew:], "PE\x00\x00")
+
+	hdr := img[lfanew+4:] // IMAGE_FILE_HEADER
+	binary.LittleEndian.PutUint16(hdr[0:], 0x8664)
+	binary.LittleEndian.PutUint16(hdr[16:], uint16(optHdrSize))
+	binary.LittleEndian.PutUint16(hdr[18:], 0x0002) // executable
+
+	opt := hdr[20:] // IMAGE_OPTIONAL_HEADER32 or IMAGE_OPTIONAL_HEADER64
+	binary.LittleEndian.PutUint16(opt[0:], magic)
+	binary.LittleEndian.PutUint32(opt[32:], 0x1000) // SectionAlignment
+	binary.LittleEndian.PutUint32(opt[36:], rawOff) // FileAlignment
+	binary.LittleEndian.PutUint32(opt[56:], _TEST_SEC_RVA+0x1000+uint32(len(rsrc))&^0xfff)
+	binary.LittleEndian.PutUint32(opt[60:], rawOff) // SizeOfHeaders
+	binary.LittleEndian.PutUint32(opt[numDirsOff:], 16)
+
+	if rsrc != nil {
+		binary.LittleEndian.PutUint16(hdr[2:], 1) // NumberOfSections
+		dir := opt[numDirsOff+4+8*2:]             // IMAGE_DIRECTORY_ENTRY_RESOURCE
+		binary.LittleEndian.PutUint32(dir[0:], _TEST_SEC_RVA)
+		binary.LittleEndian.PutUint32(dir[4:], uint32(len(rsrc)))
+
+		sec := opt[optHdrSize : optHdrSize+secHdrSize] // IMAGE_SECTION_HEADER
+		copy(sec[0:8], ".rsrc")
+		binary.LittleEndian.PutUint32(sec[8:], uint32(len(rsrc))) // VirtualSize
+		binary.LittleEndian.PutUint32(sec[12:], _TEST_SEC_RVA)
+		binary.LittleEndian.PutUint32(sec[16:], uint32(len(rsrc))) // SizeOfRawData
+		binary.LittleEndian.PutUint32(sec[20:], rawOff)
+		binary.LittleEndian.PutUint32(sec[36:], 0x4000_0040) // initialized data, read
+		copy(img[rawOff:], rsrc)
+	}
+	return img
+}
+
+// Shorthand to a directory node.
+func dirNode(id ResId, children ..._TestNode) _TestNode {
+	return _TestNode{id: id, children: children}
+}
+
+// Shorthand to a resource node, at the language level.
+func resNode(lang uint16, data []byte) _TestNode {
+	return _TestNode{id: ResIdInt(lang), data: data}
+}
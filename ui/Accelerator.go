@@ -0,0 +1,119 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Created windows which have accelerators, translated by the message loops.
+var globalAccelWnds = make(map[win.HWND]*_BaseContainer)
+
+// Adds a keyboard shortcut like "Ctrl+Shift+S" or "Alt+F4" to the window,
+// which calls fun when pressed. The shortcut is parsed with [win.ParseAccel].
+//
+// The shortcut works while the focus is on the window or on any of its
+// children. Shortcuts of a child container take precedence over those of its
+// ancestors.
+//
+// Panics if the shortcut is invalid.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	ui.AddAccelerator(wnd, "Ctrl+Shift+S", func() {
+//		println("Save as")
+//	})
+func AddAccelerator(parent Parent, shortcut string, fun func()) {
+	cmdId := nextCtrlId()
+	AddAcceleratorCmd(parent, shortcut, cmdId)
+	parent.base().beforeUserEvents.WmCommand(cmdId, co.CMD_ACCELERATOR, fun)
+}
+
+// Adds a keyboard shortcut like "Ctrl+Shift+S" or "Alt+F4" to the window,
+// which sends a WM_COMMAND with the given command ID when pressed. This allows
+// a shortcut to trigger an existing command, like a menu item. The shortcut is
+// parsed with [win.ParseAccel].
+//
+// Panics if the shortcut is invalid.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//	var mnuFile *ui.Menu
+//
+//	itemOpen := mnuFile.AddItem("&Open...", "Ctrl+O", func() {
+//		println("Open")
+//	})
+//	ui.AddAcceleratorCmd(wnd, "Ctrl+O", itemOpen.CmdId())
+func AddAcceleratorCmd(parent Parent, shortcut string, cmdId uint16) {
+	accel, err := win.ParseAccel(shortcut, cmdId)
+	if err != nil {
+		panic(err)
+	}
+	parent.base().addAccel(accel)
+}
+
+func (me *_BaseContainer) addAccel(accel win.ACCEL) {
+	if len(me.accels) == 0 { // first accelerator, start tracking the window
+		if me.hWnd != 0 {
+			globalAccelWnds[me.hWnd] = me
+		} else {
+			me.afterUserEvents.Wm(me.wndTy.initMsg(), func(_ Wm) uintptr {
+				globalAccelWnds[me.hWnd] = me
+				return 0 // ignored
+			})
+		}
+
+		me.beforeUserEvents.WmNcDestroy(func() {
+			delete(globalAccelWnds, me.hWnd)
+			me.destroyAccelTable()
+		})
+	}
+
+	me.accels = append(me.accels, accel)
+	me.destroyAccelTable() // will be rebuilt on demand
+}
+
+// Returns the accelerator table built from the accelerators of the window,
+// creating it if needed.
+func (me *_BaseContainer) accelTable() win.HACCEL {
+	if me.hAccel == 0 {
+		hAccel, err := win.CreateAcceleratorTable(me.accels)
+		if err != nil {
+			panic(err)
+		}
+		me.hAccel = hAccel
+	}
+	return me.hAccel
+}
+
+func (me *_BaseContainer) destroyAccelTable() {
+	if me.hAccel != 0 {
+		me.hAccel.DestroyAcceleratorTable()
+		me.hAccel = 0
+	}
+}
+
+// Tries to translate the keyboard message with the accelerators of the window
+// which received it, or of its ancestors up to the top-level one.
+func translateAccelerators(pMsg *win.MSG) bool {
+	if len(globalAccelWnds) == 0 ||
+		(pMsg.Msg != co.WM_KEYDOWN && pMsg.Msg != co.WM_SYSKEYDOWN) {
+		return false
+	}
+
+	for hWnd := pMsg.HWnd; hWnd != 0; hWnd, _ = hWnd.GetAncestor(co.GA_PARENT) {
+		if wnd, ok := globalAccelWnds[hWnd]; ok {
+			if hWnd.TranslateAccelerator(wnd.accelTable(), pMsg) == nil {
+				return true
+			}
+		}
+		if style, _ := hWnd.Style(); (style & co.WS_CHILD) == 0 {
+			break // top-level reached
+		}
+	}
+	return false
+}
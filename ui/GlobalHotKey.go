@@ -0,0 +1,114 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+var globalNextHotKeyId int32 = 0xbfff // IDs from 0x0000 to 0xbfff are available to applications
+
+// A system-wide hotkey, registered with [RegisterGlobalHotKey].
+//
+// The hotkey is unregistered automatically when the window is destroyed.
+type GlobalHotKey struct {
+	owner     *_BaseContainer
+	id        int32
+	modifiers co.MOD
+	vk        co.VK
+	fun       func()
+}
+
+// Registers a system-wide hotkey with [win.HWND.RegisterHotKey], which calls
+// fun when pressed, even if the application doesn't have the focus. The
+// modifiers can include co.MOD_NOREPEAT, so fun is not called repeatedly while
+// the keys are held down.
+//
+// Returns an error if the hotkey is already registered, either by this or by
+// another application.
+//
+// Panics if the window was not created yet.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	wnd.On().WmCreate(func(_ ui.WmCreate) int {
+//		ui.RegisterGlobalHotKey(wnd, co.MOD_CONTROL|co.MOD_ALT|co.MOD_NOREPEAT,
+//			co.VK_SPACE, func() {
+//				wnd.Hwnd().SetForegroundWindow()
+//			})
+//		return 0
+//	})
+func RegisterGlobalHotKey(
+	parent Parent,
+	modifiers co.MOD,
+	vk co.VK,
+	fun func(),
+) (*GlobalHotKey, error) {
+	owner := parent.base()
+	if owner.hWnd == 0 {
+		panic("Cannot register a hotkey before the window is created.")
+	}
+
+	id := globalNextHotKeyId
+	if err := owner.hWnd.RegisterHotKey(id, modifiers, vk); err != nil {
+		return nil, err
+	}
+	globalNextHotKeyId-- // go down
+
+	me := &GlobalHotKey{
+		owner:     owner,
+		id:        id,
+		modifiers: modifiers,
+		vk:        vk,
+		fun:       fun,
+	}
+	owner.trackHotKey(me)
+	return me, nil
+}
+
+func (me *_BaseContainer) trackHotKey(hk *GlobalHotKey) {
+	if me.hotKeys == nil {
+		me.hotKeys = make(map[int32]*GlobalHotKey)
+	}
+
+	if !me.hotKeysHooked { // first hotkey, install the handlers
+		me.hotKeysHooked = true
+
+		me.beforeUserEvents.WmHotKey(func(p WmHotKey) {
+			if hk, ok := me.hotKeys[int32(p.HotKey())]; ok {
+				hk.fun()
+			}
+		})
+
+		me.beforeUserEvents.WmDestroy(func() {
+			for _, hk := range me.hotKeys {
+				hk.Unregister() // also removes it from the map
+			}
+		})
+	}
+
+	me.hotKeys[hk.id] = hk
+}
+
+// Returns the modifiers of the hotkey, as passed to [RegisterGlobalHotKey].
+func (me *GlobalHotKey) Modifiers() co.MOD {
+	return me.modifiers
+}
+
+// Unregisters the hotkey with [win.HWND.UnregisterHotKey], so fun won't be
+// called anymore.
+func (me *GlobalHotKey) Unregister() error {
+	if _, ok := me.owner.hotKeys[me.id]; !ok {
+		return nil // already unregistered
+	}
+	delete(me.owner.hotKeys, me.id)
+	return me.owner.hWnd.UnregisterHotKey(me.id)
+}
+
+// Returns the virtual key code of the hotkey, as passed to
+// [RegisterGlobalHotKey].
+func (me *GlobalHotKey) Vk() co.VK {
+	return me.vk
+}
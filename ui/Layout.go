@@ -56,7 +56,19 @@ const (
 // When parent window is resized, resizes all children at once.
 type _Layout struct {
 	ctrls  []_LayoutCtrl
-	szOrig win.SIZE // Original size of parent's client area.
+	szOrig win.SIZE  // Original size of parent's client area.
+	szVirt win.SIZE  // Virtual size of parent's client area, if scrollable.
+	offset win.POINT // Current scroll position of parent's client area, if scrollable.
+	docks  []_LayoutDock
+
+	manager LayoutItem // Optional layout manager, set with SetLayout().
+}
+
+// A control docked at the top or bottom edge of the parent, like a rebar,
+// whose height is taken from the area given to the other children.
+type _LayoutDock struct {
+	hCtrl  win.HWND
+	bottom bool
 }
 
 type _LayoutCtrl struct {
@@ -81,25 +93,59 @@ func (me *_Layout) Add(parent Parent, hCtrl win.HWND, layout LAY) {
 		return // nothing to do, don't even bother adding the control
 	}
 
+	top, bottom := me.dockInsets()
+
 	if len(me.ctrls) == 0 { // first control being added?
 		rcParent, _ := parent.Hwnd().GetClientRect()
-		me.szOrig = win.SIZE{Cx: rcParent.Right, Cy: rcParent.Bottom} // save parent client area
+		me.szOrig = me.effectiveSize(win.SIZE{Cx: rcParent.Right, Cy: rcParent.Bottom}) // save parent client area
+		me.szOrig.Cy -= top + bottom
 	}
 
 	rcOrig, _ := hCtrl.GetWindowRect()      // relative to screen
 	parent.Hwnd().ScreenToClientRc(&rcOrig) // now relative to parent
 
+	rcOrig.Left, rcOrig.Right = rcOrig.Left+me.offset.X, rcOrig.Right+me.offset.X // relative to virtual area
+	rcOrig.Top, rcOrig.Bottom = rcOrig.Top+me.offset.Y-top, rcOrig.Bottom+me.offset.Y-top
+
 	me.ctrls = append(me.ctrls, _LayoutCtrl{hCtrl, rcOrig, layout})
 }
 
+// Adds a control docked at the top or bottom edge of the parent, whose height
+// is excluded from the area given to the other children. The anchored
+// positions are then relative to the remaining area.
+func (me *_Layout) AddDock(hCtrl win.HWND, bottom bool) {
+	me.docks = append(me.docks, _LayoutDock{hCtrl, bottom})
+}
+
 // Rearrange all children. To be called during WM_SIZE processing.
 func (me *_Layout) Rearrange(parm WmSize) {
-	if len(me.ctrls) == 0 || parm.Request() == co.SIZE_REQ_MINIMIZED {
+	if parm.Request() == co.SIZE_REQ_MINIMIZED {
 		return // no need to resize if window is minimized
 	}
+	me.rearrange(parm.ClientAreaSize())
+}
+
+// Immediately rearranges all children, outside WM_SIZE processing; used when
+// the height of a docked control changes.
+func (me *_Layout) RearrangeNow(hParent win.HWND) {
+	if hParent.IsIconic() {
+		return
+	}
+	rcClient, _ := hParent.GetClientRect()
+	me.rearrange(win.SIZE{Cx: rcClient.Right, Cy: rcClient.Bottom})
+}
+
+func (me *_Layout) rearrange(szClient win.SIZE) {
+	if len(me.ctrls) == 0 && me.manager == nil {
+		return
+	}
+
+	top, bottom := me.dockInsets()
+	szParent := me.effectiveSize(szClient)
+	szParent.Cy -= top + bottom
 
 	hdwp, _ := win.BeginDeferWindowPos(uint(len(me.ctrls)))
-	defer hdwp.EndDeferWindowPos()
+	defer func() { hdwp.EndDeferWindowPos() }() // the handle may be changed by the layout manager
 
 	for i := range me.ctrls {
 		ctl := me.ctrls[i]
@@ -111,8 +157,6 @@ func (me *_Layout) Rearrange(parm WmSize) {
 			uFlags |= co.SWP_NOMOVE
 		}
 
-		szParent := parm.ClientAreaSize()
-
 		x := ctl.rcOrig.Left // keep original left pos
 		if (ctl.layout & _LAYH_REPOS) != 0 {
 			x = szParent.Cx - me.szOrig.Cx + ctl.rcOrig.Left
@@ -133,6 +177,83 @@ func (me *_Layout) Rearrange(parm WmSize) {
 			cy = szParent.Cy - me.szOrig.Cy + ctl.rcOrig.Bottom - ctl.rcOrig.Top
 		}
 
-		hdwp.DeferWindowPos(ctl.hCtrl, win.HWND(0), int(x), int(y), int(cx), int(cy), uFlags)
+		hdwp.DeferWindowPos(ctl.hCtrl, win.HWND(0),
+			int(x-me.offset.X), int(y+top-me.offset.Y), int(cx), int(cy), uFlags)
+	}
+
+	if me.manager != nil {
+		me.arrangeManager(&hdwp, szClient)
+	}
+}
+
+// Lets the layout manager arrange the children within the whole virtual area,
+// except the docked controls.
+func (me *_Layout) arrangeManager(hdwp *win.HDWP, szClient win.SIZE) {
+	top, bottom := me.dockInsets()
+	szParent := me.effectiveSize(szClient)
+	me.manager.layArrange(hdwp, win.RECT{
+		Left:   -me.offset.X,
+		Top:    top - me.offset.Y,
+		Right:  szParent.Cx - me.offset.X,
+		Bottom: szParent.Cy - bottom - me.offset.Y,
+	})
+}
+
+// Returns the heights taken by the visible docked controls at the top and at
+// the bottom of the parent.
+func (me *_Layout) dockInsets() (top, bottom int32) {
+	for _, dock := range me.docks {
+		if style, _ := dock.hCtrl.GetWindowLongPtr(co.GWLP_STYLE); (co.WS(style) & co.WS_VISIBLE) == 0 {
+			continue
+		}
+		rc, _ := dock.hCtrl.GetWindowRect()
+		if dock.bottom {
+			bottom += rc.Bottom - rc.Top
+		} else {
+			top += rc.Bottom - rc.Top
+		}
+	}
+	return
+}
+
+// Immediately arranges the children with the layout manager, outside WM_SIZE
+// processing.
+func (me *_Layout) arrangeManagerNow(hParent win.HWND) {
+	rcClient, _ := hParent.GetClientRect()
+	hdwp, _ := win.BeginDeferWindowPos(8) // arbitrary, will grow as needed
+	me.arrangeManager(&hdwp, win.SIZE{Cx: rcClient.Right, Cy: rcClient.Bottom})
+	hdwp.EndDeferWindowPos()
+}
+
+// Rescales the stored positions and sizes, when the parent window DPI changes.
+func (me *_Layout) scaleDpi(oldDpi, newDpi int) {
+	me.szOrig = scaleSizeDpi(me.szOrig, oldDpi, newDpi)
+	me.szVirt = scaleSizeDpi(me.szVirt, oldDpi, newDpi)
+	me.offset = win.POINT{
+		X: scaleDpi(me.offset.X, oldDpi, newDpi),
+		Y: scaleDpi(me.offset.Y, oldDpi, newDpi),
+	}
+
+	for i := range me.ctrls {
+		rc := &me.ctrls[i].rcOrig
+		rc.Left, rc.Top = scaleDpi(rc.Left, oldDpi, newDpi), scaleDpi(rc.Top, oldDpi, newDpi)
+		rc.Right, rc.Bottom = scaleDpi(rc.Right, oldDpi, newDpi), scaleDpi(rc.Bottom, oldDpi, newDpi)
+	}
+
+	if me.manager != nil {
+		me.manager.layScaleDpi(oldDpi, newDpi)
+	}
+}
+
+// If the parent is scrollable, its virtual area is at least as big as the
+// visible client area.
+func (me *_Layout) effectiveSize(szClient win.SIZE) win.SIZE {
+	sz := szClient
+	if me.szVirt.Cx > sz.Cx {
+		sz.Cx = me.szVirt.Cx
+	}
+	if me.szVirt.Cy > sz.Cy {
+		sz.Cy = me.szVirt.Cy
 	}
+	return sz
 }
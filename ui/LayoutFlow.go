@@ -0,0 +1,168 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+)
+
+// Layout manager which places its items side by side, with their preferred
+// sizes, wrapping them into a new line when there's no horizontal space left.
+// Each line is as tall as its tallest item.
+//
+// Implements [LayoutItem].
+type Flow struct {
+	items   []LayoutItem
+	gap     win.SIZE
+	margins win.SIZE
+}
+
+// Creates a new [Flow], which must be set with [SetLayout], or added to
+// another layout manager.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//	var chkBold, chkItalic, chkUnderline *ui.CheckBox
+//
+//	ui.SetLayout(wnd,
+//		ui.NewFlow(ui.OptsFlow()).
+//			Add(ui.Lay(chkBold), ui.Lay(chkItalic), ui.Lay(chkUnderline)),
+//	)
+func NewFlow(opts *VarOptsFlow) *Flow {
+	return &Flow{
+		items:   make([]LayoutItem, 0, 8), // arbitrary
+		gap:     opts.gap,
+		margins: opts.margins,
+	}
+}
+
+// Adds the items at the end of the flow.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Flow) Add(items ...LayoutItem) *Flow {
+	me.items = append(me.items, items...)
+	return me
+}
+
+// Implements [LayoutItem].
+func (me *Flow) layMeasure(cxAvail int32) win.SIZE {
+	cxInner := int32(-1) // unlimited: all items in a single line
+	if cxAvail >= 0 {
+		cxInner = cxAvail - me.margins.Cx*2
+		if cxInner < 0 {
+			cxInner = 0
+		}
+	}
+
+	lines, _ := me.breakLines(cxInner)
+	var sz win.SIZE
+	for _, line := range lines {
+		if line.cx > sz.Cx {
+			sz.Cx = line.cx
+		}
+		sz.Cy += line.cy
+	}
+
+	if len(lines) > 0 {
+		sz.Cy += me.gap.Cy * int32(len(lines)-1)
+	}
+	sz.Cx += me.margins.Cx * 2
+	sz.Cy += me.margins.Cy * 2
+	return sz
+}
+
+// Implements [LayoutItem].
+func (me *Flow) layArrange(hdwp *win.HDWP, rc win.RECT) {
+	inner := win.RECT{
+		Left:   rc.Left + me.margins.Cx,
+		Top:    rc.Top + me.margins.Cy,
+		Right:  rc.Right - me.margins.Cx,
+		Bottom: rc.Bottom - me.margins.Cy,
+	}
+
+	lines, sizes := me.breakLines(inner.Right - inner.Left)
+	y := inner.Top
+	for _, line := range lines {
+		x := inner.Left
+		for i := line.first; i < line.first+line.count; i++ {
+			me.items[i].layArrange(hdwp, win.RECT{
+				Left:   x,
+				Top:    y,
+				Right:  x + sizes[i].Cx,
+				Bottom: y + line.cy,
+			})
+			x += sizes[i].Cx + me.gap.Cx
+		}
+		y += line.cy + me.gap.Cy
+	}
+}
+
+// Implements [LayoutItem].
+func (me *Flow) layScaleDpi(oldDpi, newDpi int) {
+	me.gap = scaleSizeDpi(me.gap, oldDpi, newDpi)
+	me.margins = scaleSizeDpi(me.margins, oldDpi, newDpi)
+	for _, item := range me.items {
+		item.layScaleDpi(oldDpi, newDpi)
+	}
+}
+
+type _FlowLine struct {
+	first, count int
+	cx, cy       int32
+}
+
+// Measures the items and splits them into lines, which fit the given width; if
+// negative, all items are placed in a single line.
+func (me *Flow) breakLines(cxAvail int32) ([]_FlowLine, []win.SIZE) {
+	sizes := make([]win.SIZE, len(me.items))
+	lines := make([]_FlowLine, 0, 2) // arbitrary
+
+	for i, item := range me.items {
+		sizes[i] = item.layMeasure(-1)
+
+		if len(lines) > 0 {
+			line := &lines[len(lines)-1]
+			if cxAvail < 0 || line.cx+me.gap.Cx+sizes[i].Cx <= cxAvail {
+				line.count++
+				line.cx += me.gap.Cx + sizes[i].Cx
+				if sizes[i].Cy > line.cy {
+					line.cy = sizes[i].Cy
+				}
+				continue
+			}
+		}
+		lines = append(lines, _FlowLine{i, 1, sizes[i].Cx, sizes[i].Cy}) // a line always has at least 1 item
+	}
+	return lines, sizes
+}
+
+// Options for [NewFlow]; returned by [OptsFlow].
+type VarOptsFlow struct {
+	gap     win.SIZE
+	margins win.SIZE
+}
+
+// Options for [NewFlow].
+func OptsFlow() *VarOptsFlow {
+	return &VarOptsFlow{
+		gap: win.SIZE{Cx: int32(DpiX(6)), Cy: int32(DpiY(6))},
+	}
+}
+
+// Horizontal space between the items, and vertical space between the lines,
+// in pixels.
+//
+// Defaults to ui.Dpi(6, 6).
+func (o *VarOptsFlow) Gap(horz, vert int) *VarOptsFlow {
+	o.gap = win.SIZE{Cx: int32(horz), Cy: int32(vert)}
+	return o
+}
+
+// Horizontal and vertical space around the items, in pixels.
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsFlow) Margins(horz, vert int) *VarOptsFlow {
+	o.margins = win.SIZE{Cx: int32(horz), Cy: int32(vert)}
+	return o
+}
@@ -0,0 +1,260 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+)
+
+// Layout manager which arranges its items in rows and columns. An item can
+// span multiple rows and columns.
+//
+// Each column is as wide as its widest item, and each row is as tall as its
+// tallest item. The remaining space is distributed among the columns and rows
+// according to their weights; by default, all weights are zero, so the grid
+// doesn't grow.
+//
+// Implements [LayoutItem].
+type Grid struct {
+	cells      []_GridCell
+	colWeights []int
+	rowWeights []int
+	gap        win.SIZE
+	margins    win.SIZE
+}
+
+type _GridCell struct {
+	item             LayoutItem
+	row, col         int
+	rowSpan, colSpan int
+}
+
+// Creates a new [Grid], which must be set with [SetLayout], or added to
+// another layout manager.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//	var lblName *ui.Static
+//	var txtName *ui.Edit
+//	var txtNotes *ui.Edit
+//
+//	ui.SetLayout(wnd,
+//		ui.NewGrid(
+//			ui.OptsGrid().
+//				ColWeights(0, 1).
+//				RowWeights(0, 1),
+//		).
+//			Add(ui.Lay(lblName), 0, 0).
+//			Add(ui.Lay(txtName), 0, 1).
+//			AddSpan(ui.Lay(txtNotes).Align(ui.ALIGN_FILL, ui.ALIGN_FILL), 1, 0, 1, 2),
+//	)
+func NewGrid(opts *VarOptsGrid) *Grid {
+	return &Grid{
+		cells:      make([]_GridCell, 0, 8), // arbitrary
+		colWeights: opts.colWeights,
+		rowWeights: opts.rowWeights,
+		gap:        opts.gap,
+		margins:    opts.margins,
+	}
+}
+
+// Adds an item to the given zero-based cell.
+//
+// Panics if row or col is negative.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Grid) Add(item LayoutItem, row, col int) *Grid {
+	return me.AddSpan(item, row, col, 1, 1)
+}
+
+// Adds an item which starts at the given zero-based cell, spanning the given
+// number of rows and columns.
+//
+// Panics if row or col is negative, or if rowSpan or colSpan is less than 1.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Grid) AddSpan(item LayoutItem, row, col, rowSpan, colSpan int) *Grid {
+	if row < 0 || col < 0 {
+		panic("Grid row and column cannot be negative.")
+	}
+	if rowSpan < 1 || colSpan < 1 {
+		panic("Grid row and column spans must be at least 1.")
+	}
+	me.cells = append(me.cells, _GridCell{item, row, col, rowSpan, colSpan})
+	return me
+}
+
+// Implements [LayoutItem].
+func (me *Grid) layMeasure(_ int32) win.SIZE {
+	colWidths, rowHeights := me.measureTracks()
+	return win.SIZE{
+		Cx: sumTracks(colWidths, me.gap.Cx) + me.margins.Cx*2,
+		Cy: sumTracks(rowHeights, me.gap.Cy) + me.margins.Cy*2,
+	}
+}
+
+// Implements [LayoutItem].
+func (me *Grid) layArrange(hdwp *win.HDWP, rc win.RECT) {
+	colWidths, rowHeights := me.measureTracks()
+	if len(colWidths) == 0 {
+		return // no items
+	}
+
+	inner := win.RECT{
+		Left:   rc.Left + me.margins.Cx,
+		Top:    rc.Top + me.margins.Cy,
+		Right:  rc.Right - me.margins.Cx,
+		Bottom: rc.Bottom - me.margins.Cy,
+	}
+	distributeByWeight(colWidths, me.colWeights,
+		inner.Right-inner.Left-me.gap.Cx*int32(len(colWidths)-1))
+	distributeByWeight(rowHeights, me.rowWeights,
+		inner.Bottom-inner.Top-me.gap.Cy*int32(len(rowHeights)-1))
+
+	colPos := trackPositions(colWidths, inner.Left, me.gap.Cx)
+	rowPos := trackPositions(rowHeights, inner.Top, me.gap.Cy)
+
+	for _, cell := range me.cells {
+		lastCol := cell.col + cell.colSpan - 1
+		lastRow := cell.row + cell.rowSpan - 1
+		cell.item.layArrange(hdwp, win.RECT{
+			Left:   colPos[cell.col],
+			Top:    rowPos[cell.row],
+			Right:  colPos[lastCol] + colWidths[lastCol],
+			Bottom: rowPos[lastRow] + rowHeights[lastRow],
+		})
+	}
+}
+
+// Implements [LayoutItem].
+func (me *Grid) layScaleDpi(oldDpi, newDpi int) {
+	me.gap = scaleSizeDpi(me.gap, oldDpi, newDpi)
+	me.margins = scaleSizeDpi(me.margins, oldDpi, newDpi)
+	for _, cell := range me.cells {
+		cell.item.layScaleDpi(oldDpi, newDpi)
+	}
+}
+
+// Calculates the preferred width of each column and height of each row.
+func (me *Grid) measureTracks() (colWidths, rowHeights []int32) {
+	numCols, numRows := 0, 0
+	for _, cell := range me.cells {
+		if cell.col+cell.colSpan > numCols {
+			numCols = cell.col + cell.colSpan
+		}
+		if cell.row+cell.rowSpan > numRows {
+			numRows = cell.row + cell.rowSpan
+		}
+	}
+
+	sizes := make([]win.SIZE, len(me.cells))
+	for i, cell := range me.cells {
+		sizes[i] = cell.item.layMeasure(-1)
+	}
+
+	colWidths = make([]int32, numCols)
+	rowHeights = make([]int32, numRows)
+
+	for i, cell := range me.cells { // single cells come first
+		if cell.colSpan == 1 && sizes[i].Cx > colWidths[cell.col] {
+			colWidths[cell.col] = sizes[i].Cx
+		}
+		if cell.rowSpan == 1 && sizes[i].Cy > rowHeights[cell.row] {
+			rowHeights[cell.row] = sizes[i].Cy
+		}
+	}
+	for i, cell := range me.cells { // then spanned cells, which may enlarge their tracks
+		if cell.colSpan > 1 {
+			growSpannedTracks(colWidths[cell.col:cell.col+cell.colSpan],
+				sizes[i].Cx-me.gap.Cx*int32(cell.colSpan-1))
+		}
+		if cell.rowSpan > 1 {
+			growSpannedTracks(rowHeights[cell.row:cell.row+cell.rowSpan],
+				sizes[i].Cy-me.gap.Cy*int32(cell.rowSpan-1))
+		}
+	}
+	return
+}
+
+// If the tracks are smaller than needed, evenly distributes the difference.
+func growSpannedTracks(tracks []int32, needed int32) {
+	var sum int32
+	for _, track := range tracks {
+		sum += track
+	}
+	if missing := needed - sum; missing > 0 {
+		n := int32(len(tracks))
+		for i := range tracks {
+			tracks[i] += missing / n
+		}
+		tracks[len(tracks)-1] += missing % n
+	}
+}
+
+// Returns the sum of the track sizes, plus the gaps between them.
+func sumTracks(tracks []int32, gap int32) int32 {
+	if len(tracks) == 0 {
+		return 0
+	}
+	sum := gap * int32(len(tracks)-1)
+	for _, track := range tracks {
+		sum += track
+	}
+	return sum
+}
+
+// Returns the starting coordinate of each track.
+func trackPositions(tracks []int32, start, gap int32) []int32 {
+	positions := make([]int32, len(tracks))
+	pos := start
+	for i, track := range tracks {
+		positions[i] = pos
+		pos += track + gap
+	}
+	return positions
+}
+
+// Options for [NewGrid]; returned by [OptsGrid].
+type VarOptsGrid struct {
+	colWeights []int
+	rowWeights []int
+	gap        win.SIZE
+	margins    win.SIZE
+}
+
+// Options for [NewGrid].
+func OptsGrid() *VarOptsGrid {
+	return &VarOptsGrid{
+		gap: win.SIZE{Cx: int32(DpiX(8)), Cy: int32(DpiY(6))},
+	}
+}
+
+// Weights of the columns, which distribute the remaining horizontal space
+// among them. Columns with zero weight keep their preferred width.
+//
+// Defaults to zero for all columns.
+func (o *VarOptsGrid) ColWeights(w ...int) *VarOptsGrid { o.colWeights = w; return o }
+
+// Weights of the rows, which distribute the remaining vertical space among
+// them. Rows with zero weight keep their preferred height.
+//
+// Defaults to zero for all rows.
+func (o *VarOptsGrid) RowWeights(w ...int) *VarOptsGrid { o.rowWeights = w; return o }
+
+// Horizontal space between the columns, and vertical space between the rows,
+// in pixels.
+//
+// Defaults to ui.Dpi(8, 6).
+func (o *VarOptsGrid) Gap(horz, vert int) *VarOptsGrid {
+	o.gap = win.SIZE{Cx: int32(horz), Cy: int32(vert)}
+	return o
+}
+
+// Horizontal and vertical space around the items, in pixels.
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsGrid) Margins(horz, vert int) *VarOptsGrid {
+	o.margins = win.SIZE{Cx: int32(horz), Cy: int32(vert)}
+	return o
+}
@@ -0,0 +1,297 @@
+//go:build windows
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// An element which can be arranged by a layout manager: either a child control,
+// wrapped with [Lay], or another layout manager – [Grid], [Stack] or [Flow] –,
+// so layouts can be nested.
+type LayoutItem interface {
+	// Returns the preferred size of the item. If cxAvail is not negative, it's
+	// the width available to the item.
+	layMeasure(cxAvail int32) win.SIZE
+
+	// Positions the item within the given rectangle, relative to the parent's
+	// client area.
+	layArrange(hdwp *win.HDWP, rc win.RECT)
+
+	// Rescales the sizes given in pixels, when the parent window DPI changes.
+	layScaleDpi(oldDpi, newDpi int)
+}
+
+// Sets the layout manager which arranges all the children of the parent
+// window, whenever the parent is resized. The layout manager is given the
+// whole client area.
+//
+// The controls arranged by the layout manager should be created with
+// ui.LAY_NONE_NONE, so they're not also moved by the anchors.
+//
+// A [Control] can also have its own layout manager, while being arranged by
+// the layout manager of its parent; in this case, its preferred size is given
+// by its own layout manager.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//	var lblName, lblMail *ui.Static
+//	var txtName, txtMail *ui.Edit
+//	var btnOk, btnCancel *ui.Button
+//
+//	ui.SetLayout(wnd,
+//		ui.NewStack(ui.OptsStack().Margins(ui.Dpi(10, 10))).
+//			Add(ui.NewGrid(ui.OptsGrid().ColWeights(0, 1)).
+//				Add(ui.Lay(lblName), 0, 0).
+//				Add(ui.Lay(txtName), 0, 1).
+//				Add(ui.Lay(lblMail), 1, 0).
+//				Add(ui.Lay(txtMail), 1, 1), 1).
+//			Add(ui.NewFlow(ui.OptsFlow()).
+//				Add(ui.Lay(btnOk), ui.Lay(btnCancel)), 0),
+//	)
+func SetLayout(parent Parent, root LayoutItem) {
+	arrange := func() {
+		if sysDpi, dpi := systemDpi(), DpiOf(parent); dpi != sysDpi {
+			root.layScaleDpi(sysDpi, dpi) // gaps and margins are given in system DPI
+		}
+		layout := &parent.base().layout
+		layout.manager = root
+		layout.arrangeManagerNow(parent.Hwnd())
+	}
+
+	if parent.Hwnd() != 0 {
+		arrange()
+	} else {
+		// Children are created on WM_CREATE or WM_INITDIALOG, before this handler runs.
+		parent.base().afterUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+			arrange()
+			return 0 // ignored
+		})
+	}
+}
+
+// Alignment of a control within the area given to it by a layout manager.
+type ALIGN uint8
+
+const (
+	// The control is stretched to fill the whole area.
+	ALIGN_FILL ALIGN = iota
+	// The control keeps its preferred size, aligned at left or top.
+	ALIGN_START
+	// The control keeps its preferred size, centered.
+	ALIGN_CENTER
+	// The control keeps its preferred size, aligned at right or bottom.
+	ALIGN_END
+)
+
+// A child control wrapped to be arranged by a layout manager; created with
+// [Lay].
+//
+// Implements [LayoutItem].
+type LayoutCtrl struct {
+	ctrl           ChildControl
+	alignH, alignV ALIGN
+	szPref         win.SIZE // Preferred size set by the user; negative values are measured.
+	szOrig         win.SIZE // Size of the control when first measured.
+	hasOrig        bool
+}
+
+// Wraps a child control, so it can be arranged by a layout manager.
+//
+// The preferred size of the control is calculated from its text, for statics,
+// buttons, check boxes and radio buttons; for other controls, it's the size
+// the control was created with. By default, the control fills its area
+// horizontally, and it's vertically centered.
+func Lay(ctrl ChildControl) *LayoutCtrl {
+	return &LayoutCtrl{
+		ctrl:   ctrl,
+		alignH: ALIGN_FILL,
+		alignV: ALIGN_CENTER,
+		szPref: win.SIZE{Cx: -1, Cy: -1},
+	}
+}
+
+// Sets the horizontal and vertical alignment of the control within its area.
+//
+// Returns the same object, so further operations can be chained.
+func (me *LayoutCtrl) Align(horz, vert ALIGN) *LayoutCtrl {
+	me.alignH = horz
+	me.alignV = vert
+	return me
+}
+
+// Sets the preferred size of the control, in pixels, overriding the calculated
+// one. Use -1 to keep the calculated width or height.
+//
+// Returns the same object, so further operations can be chained.
+func (me *LayoutCtrl) Size(cx, cy int) *LayoutCtrl {
+	me.szPref = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	return me
+}
+
+// Implements [LayoutItem].
+func (me *LayoutCtrl) layMeasure(_ int32) win.SIZE {
+	hCtrl := me.ctrl.Hwnd()
+	if hCtrl == 0 {
+		return win.SIZE{} // not created yet
+	}
+	if !me.hasOrig {
+		rc, _ := hCtrl.GetWindowRect()
+		me.szOrig = win.SIZE{Cx: rc.Right - rc.Left, Cy: rc.Bottom - rc.Top}
+		me.hasOrig = true
+	}
+
+	sz := me.measureContents(hCtrl)
+	if me.szPref.Cx >= 0 {
+		sz.Cx = me.szPref.Cx
+	}
+	if me.szPref.Cy >= 0 {
+		sz.Cy = me.szPref.Cy
+	}
+	return sz
+}
+
+// Calculates the size needed by the contents of the control.
+func (me *LayoutCtrl) measureContents(hCtrl win.HWND) win.SIZE {
+	if parent, ok := me.ctrl.(Parent); ok && parent.base().layout.manager != nil {
+		sz := parent.base().layout.manager.layMeasure(-1)
+		rcWnd, _ := hCtrl.GetWindowRect()
+		rcClient, _ := hCtrl.GetClientRect()
+		sz.Cx += (rcWnd.Right - rcWnd.Left) - rcClient.Right // borders
+		sz.Cy += (rcWnd.Bottom - rcWnd.Top) - rcClient.Bottom
+		return sz
+	}
+
+	className, _ := hCtrl.GetClassName()
+	style, _ := hCtrl.Style()
+	text, _ := hCtrl.GetWindowText()
+	text = utl.RemoveAccelAmpersands(text)
+
+	switch {
+	case strings.EqualFold(className, "Static"):
+		if text != "" && (co.SS(style)&co.SS_TYPEMASK) < co.SS_ICON {
+			sz, _ := calcTextBoundBox(hCtrl, text)
+			return sz
+		}
+	case strings.EqualFold(className, "Button"):
+		switch co.BS(style) & co.BS_TYPEMASK {
+		case co.BS_CHECKBOX, co.BS_AUTOCHECKBOX, co.BS_3STATE, co.BS_AUTO3STATE,
+			co.BS_RADIOBUTTON, co.BS_AUTORADIOBUTTON:
+			sz, _ := calcTextBoundBoxWithCheck(hCtrl, text)
+			return sz
+		case co.BS_PUSHBUTTON, co.BS_DEFPUSHBUTTON:
+			sz, _ := calcTextBoundBox(hCtrl, text)
+			dpi := windowDpi(hCtrl)
+			sz.Cx += int32(24 * dpi / 96) // room for the button borders
+			sz.Cy += int32(10 * dpi / 96)
+			return maxSize(sz, me.szOrig)
+		}
+	}
+	return me.szOrig
+}
+
+// Implements [LayoutItem].
+func (me *LayoutCtrl) layArrange(hdwp *win.HDWP, rc win.RECT) {
+	hCtrl := me.ctrl.Hwnd()
+	if hCtrl == 0 {
+		return
+	}
+
+	szPref := me.layMeasure(rc.Right - rc.Left)
+	x, cx := alignWithin(me.alignH, rc.Left, rc.Right, szPref.Cx)
+	y, cy := alignWithin(me.alignV, rc.Top, rc.Bottom, szPref.Cy)
+
+	if hNew, err := hdwp.DeferWindowPos(hCtrl, win.HWND(0),
+		int(x), int(y), int(cx), int(cy), co.SWP_NOZORDER|co.SWP_NOACTIVATE); err == nil {
+		*hdwp = hNew
+	}
+}
+
+// Implements [LayoutItem].
+func (me *LayoutCtrl) layScaleDpi(oldDpi, newDpi int) {
+	if me.szPref.Cx > 0 {
+		me.szPref.Cx = scaleDpi(me.szPref.Cx, oldDpi, newDpi)
+	}
+	if me.szPref.Cy > 0 {
+		me.szPref.Cy = scaleDpi(me.szPref.Cy, oldDpi, newDpi)
+	}
+	if me.hasOrig {
+		me.szOrig = scaleSizeDpi(me.szOrig, oldDpi, newDpi)
+	}
+}
+
+// Returns the position and size of an item with the given preferred size,
+// aligned within the start and end coordinates.
+func alignWithin(align ALIGN, start, end, pref int32) (pos, size int32) {
+	avail := end - start
+	if avail < 0 {
+		avail = 0
+	}
+	if align == ALIGN_FILL || pref > avail {
+		return start, avail
+	}
+
+	switch align {
+	case ALIGN_CENTER:
+		return start + (avail-pref)/2, pref
+	case ALIGN_END:
+		return end - pref, pref
+	default:
+		return start, pref
+	}
+}
+
+// Distributes the difference between the available space and the sum of the
+// sizes among the sizes with positive weights, proportionally. The sizes are
+// never made negative.
+func distributeByWeight(sizes []int32, weights []int, avail int32) {
+	totalWeight := 0
+	for i := range sizes {
+		if i < len(weights) && weights[i] > 0 {
+			totalWeight += weights[i]
+		}
+	}
+	if totalWeight == 0 {
+		return // nothing can grow or shrink
+	}
+
+	var sum int32
+	for _, sz := range sizes {
+		sum += sz
+	}
+	extra := avail - sum
+	remaining := extra
+
+	lastWeighted := -1
+	for i := range sizes {
+		if i < len(weights) && weights[i] > 0 {
+			share := int32(int64(extra) * int64(weights[i]) / int64(totalWeight))
+			sizes[i] += share
+			remaining -= share
+			lastWeighted = i
+		}
+	}
+	sizes[lastWeighted] += remaining // rounding leftovers
+
+	for i := range sizes {
+		if sizes[i] < 0 {
+			sizes[i] = 0
+		}
+	}
+}
+
+// Returns the largest width and height of both sizes.
+func maxSize(a, b win.SIZE) win.SIZE {
+	if b.Cx > a.Cx {
+		a.Cx = b.Cx
+	}
+	if b.Cy > a.Cy {
+		a.Cy = b.Cy
+	}
+	return a
+}
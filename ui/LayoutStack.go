@@ -0,0 +1,208 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+)
+
+// Orientation of a [Stack].
+type STACK uint8
+
+const (
+	// The items are placed one below the other.
+	STACK_VERT STACK = iota
+	// The items are placed side by side.
+	STACK_HORZ
+)
+
+// Layout manager which places its items in a single column or row. Each item
+// is given its preferred height – or width, if horizontal –, and the whole
+// width – or height – of the stack.
+//
+// The remaining space is distributed among the items according to their
+// weights.
+//
+// Implements [LayoutItem].
+type Stack struct {
+	orientation STACK
+	items       []LayoutItem
+	weights     []int
+	gap         int32
+	margins     win.SIZE
+}
+
+// Creates a new [Stack], which must be set with [SetLayout], or added to
+// another layout manager.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//	var lstFiles *ui.ListView
+//	var lblStatus *ui.Static
+//
+//	ui.SetLayout(wnd,
+//		ui.NewStack(
+//			ui.OptsStack().
+//				Margins(ui.Dpi(10, 10)),
+//		).
+//			Add(ui.Lay(lstFiles).Align(ui.ALIGN_FILL, ui.ALIGN_FILL), 1).
+//			Add(ui.Lay(lblStatus), 0),
+//	)
+func NewStack(opts *VarOptsStack) *Stack {
+	gap := opts.gap
+	if gap < 0 {
+		if opts.orientation == STACK_HORZ {
+			gap = int32(DpiX(6))
+		} else {
+			gap = int32(DpiY(6))
+		}
+	}
+
+	return &Stack{
+		orientation: opts.orientation,
+		items:       make([]LayoutItem, 0, 8), // arbitrary
+		weights:     make([]int, 0, 8),
+		gap:         gap,
+		margins:     opts.margins,
+	}
+}
+
+// Adds an item at the end of the stack. The weight tells how much of the
+// remaining space the item receives; zero keeps its preferred size.
+//
+// Panics if weight is negative.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Stack) Add(item LayoutItem, weight int) *Stack {
+	if weight < 0 {
+		panic("Stack item weight cannot be negative.")
+	}
+	me.items = append(me.items, item)
+	me.weights = append(me.weights, weight)
+	return me
+}
+
+// Implements [LayoutItem].
+func (me *Stack) layMeasure(cxAvail int32) win.SIZE {
+	cxInner := int32(-1)
+	if cxAvail >= 0 && me.orientation == STACK_VERT {
+		cxInner = cxAvail - me.margins.Cx*2
+		if cxInner < 0 {
+			cxInner = 0
+		}
+	}
+
+	var sz win.SIZE
+	for _, item := range me.items {
+		szItem := item.layMeasure(cxInner)
+		if me.orientation == STACK_VERT {
+			sz.Cx = maxSize(sz, szItem).Cx
+			sz.Cy += szItem.Cy
+		} else {
+			sz.Cx += szItem.Cx
+			sz.Cy = maxSize(sz, szItem).Cy
+		}
+	}
+
+	if len(me.items) > 0 {
+		if me.orientation == STACK_VERT {
+			sz.Cy += me.gap * int32(len(me.items)-1)
+		} else {
+			sz.Cx += me.gap * int32(len(me.items)-1)
+		}
+	}
+	sz.Cx += me.margins.Cx * 2
+	sz.Cy += me.margins.Cy * 2
+	return sz
+}
+
+// Implements [LayoutItem].
+func (me *Stack) layArrange(hdwp *win.HDWP, rc win.RECT) {
+	if len(me.items) == 0 {
+		return
+	}
+
+	inner := win.RECT{
+		Left:   rc.Left + me.margins.Cx,
+		Top:    rc.Top + me.margins.Cy,
+		Right:  rc.Right - me.margins.Cx,
+		Bottom: rc.Bottom - me.margins.Cy,
+	}
+	gaps := me.gap * int32(len(me.items)-1)
+
+	sizes := make([]int32, len(me.items)) // along the stack direction
+	for i, item := range me.items {
+		if me.orientation == STACK_VERT {
+			sizes[i] = item.layMeasure(inner.Right - inner.Left).Cy
+		} else {
+			sizes[i] = item.layMeasure(-1).Cx
+		}
+	}
+
+	if me.orientation == STACK_VERT {
+		distributeByWeight(sizes, me.weights, inner.Bottom-inner.Top-gaps)
+		positions := trackPositions(sizes, inner.Top, me.gap)
+		for i, item := range me.items {
+			item.layArrange(hdwp, win.RECT{
+				Left:   inner.Left,
+				Top:    positions[i],
+				Right:  inner.Right,
+				Bottom: positions[i] + sizes[i],
+			})
+		}
+	} else {
+		distributeByWeight(sizes, me.weights, inner.Right-inner.Left-gaps)
+		positions := trackPositions(sizes, inner.Left, me.gap)
+		for i, item := range me.items {
+			item.layArrange(hdwp, win.RECT{
+				Left:   positions[i],
+				Top:    inner.Top,
+				Right:  positions[i] + sizes[i],
+				Bottom: inner.Bottom,
+			})
+		}
+	}
+}
+
+// Implements [LayoutItem].
+func (me *Stack) layScaleDpi(oldDpi, newDpi int) {
+	me.gap = scaleDpi(me.gap, oldDpi, newDpi)
+	me.margins = scaleSizeDpi(me.margins, oldDpi, newDpi)
+	for _, item := range me.items {
+		item.layScaleDpi(oldDpi, newDpi)
+	}
+}
+
+// Options for [NewStack]; returned by [OptsStack].
+type VarOptsStack struct {
+	orientation STACK
+	gap         int32
+	margins     win.SIZE
+}
+
+// Options for [NewStack].
+func OptsStack() *VarOptsStack {
+	return &VarOptsStack{
+		gap: -1, // DPI-adjusted according to the orientation
+	}
+}
+
+// Whether the items are placed vertically or horizontally.
+//
+// Defaults to ui.STACK_VERT.
+func (o *VarOptsStack) Orientation(s STACK) *VarOptsStack { o.orientation = s; return o }
+
+// Space between the items, in pixels.
+//
+// Defaults to ui.DpiY(6) for vertical stacks, and ui.DpiX(6) for horizontal
+// ones.
+func (o *VarOptsStack) Gap(g int) *VarOptsStack { o.gap = int32(g); return o }
+
+// Horizontal and vertical space around the items, in pixels.
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsStack) Margins(horz, vert int) *VarOptsStack {
+	o.margins = win.SIZE{Cx: int32(horz), Cy: int32(vert)}
+	return o
+}
@@ -0,0 +1,215 @@
+//go:build windows
+
+package ui
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// A low-level keyboard or mouse hook, which sees the input events of the whole
+// system, installed with [win.SetWindowsHookEx] on a dedicated OS thread which
+// runs its own message loop.
+//
+// The events are delivered in the hook thread, not in the UI thread; to update
+// the UI, use [Parent.UiThread]. Since Windows silently removes hooks which
+// take too long to return, the processing should be quick.
+//
+// Other hook types, like co.WH_CBT and co.WH_GETMESSAGE, can only watch the
+// threads of the current process, so they should be installed directly with
+// [win.SetWindowsHookEx], passing the ID of the UI thread.
+type LowLevelHook struct {
+	threadId uint32
+	done     chan struct{}
+}
+
+// Keyboard event received by a [LowLevelHook].
+type KeyboardHookEvent struct {
+	Msg  co.WM // co.WM_KEYDOWN, co.WM_KEYUP, co.WM_SYSKEYDOWN or co.WM_SYSKEYUP.
+	Info win.KBDLLHOOKSTRUCT
+}
+
+// Mouse event received by a [LowLevelHook].
+type MouseHookEvent struct {
+	Msg  co.WM // Mouse message, like co.WM_MOUSEMOVE or co.WM_LBUTTONDOWN.
+	Info win.MSLLHOOKSTRUCT
+}
+
+// Installs a co.WH_KEYBOARD_LL hook, which calls fun for each keyboard event in
+// the system. If fun returns true, the event is blocked, so it won't reach the
+// other hooks and the target window.
+//
+// ⚠️ You must defer [LowLevelHook.Stop].
+//
+// # Example
+//
+//	hook, _ := ui.NewKeyboardHook(func(e ui.KeyboardHookEvent) bool {
+//		if e.Msg == co.WM_KEYDOWN {
+//			println("Key", e.Info.VkCode())
+//		}
+//		return false
+//	})
+//	defer hook.Stop()
+func NewKeyboardHook(fun func(e KeyboardHookEvent) (block bool)) (*LowLevelHook, error) {
+	return startLowLevelHook(co.WH_KEYBOARD_LL,
+		func(code int32, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+			if co.HC(code) == co.HC_ACTION {
+				e := KeyboardHookEvent{
+					Msg:  co.WM(wParam),
+					Info: *(*win.KBDLLHOOKSTRUCT)(unsafe.Pointer(lParam)),
+				}
+				if fun(e) {
+					return 1 // blocked
+				}
+			}
+			return win.HHOOK(0).CallNextHookEx(code, wParam, lParam)
+		}, nil)
+}
+
+// Installs a co.WH_KEYBOARD_LL hook which sends each keyboard event in the
+// system to the returned channel. If the channel buffer is full, events are
+// dropped, so the hook never blocks. The channel is closed when the hook is
+// stopped.
+//
+// ⚠️ You must defer [LowLevelHook.Stop].
+//
+// # Example
+//
+//	hook, ch, _ := ui.NewKeyboardHookChan(64)
+//	defer hook.Stop()
+//
+//	go func() {
+//		for e := range ch {
+//			println("Key", e.Info.VkCode())
+//		}
+//	}()
+func NewKeyboardHookChan(bufSize int) (*LowLevelHook, <-chan KeyboardHookEvent, error) {
+	ch := make(chan KeyboardHookEvent, bufSize)
+	hook, err := startLowLevelHook(co.WH_KEYBOARD_LL,
+		func(code int32, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+			if co.HC(code) == co.HC_ACTION {
+				select {
+				case ch <- KeyboardHookEvent{
+					Msg:  co.WM(wParam),
+					Info: *(*win.KBDLLHOOKSTRUCT)(unsafe.Pointer(lParam)),
+				}:
+				default: // buffer full, drop the event
+				}
+			}
+			return win.HHOOK(0).CallNextHookEx(code, wParam, lParam)
+		}, func() { close(ch) })
+	if err != nil {
+		return nil, nil, err
+	}
+	return hook, ch, nil
+}
+
+// Installs a co.WH_MOUSE_LL hook, which calls fun for each mouse event in the
+// system. If fun returns true, the event is blocked, so it won't reach the
+// other hooks and the target window.
+//
+// ⚠️ You must defer [LowLevelHook.Stop].
+func NewMouseHook(fun func(e MouseHookEvent) (block bool)) (*LowLevelHook, error) {
+	return startLowLevelHook(co.WH_MOUSE_LL,
+		func(code int32, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+			if co.HC(code) == co.HC_ACTION {
+				e := MouseHookEvent{
+					Msg:  co.WM(wParam),
+					Info: *(*win.MSLLHOOKSTRUCT)(unsafe.Pointer(lParam)),
+				}
+				if fun(e) {
+					return 1 // blocked
+				}
+			}
+			return win.HHOOK(0).CallNextHookEx(code, wParam, lParam)
+		}, nil)
+}
+
+// Installs a co.WH_MOUSE_LL hook which sends each mouse event in the system to
+// the returned channel. If the channel buffer is full, events are dropped, so
+// the hook never blocks. The channel is closed when the hook is stopped.
+//
+// ⚠️ You must defer [LowLevelHook.Stop].
+func NewMouseHookChan(bufSize int) (*LowLevelHook, <-chan MouseHookEvent, error) {
+	ch := make(chan MouseHookEvent, bufSize)
+	hook, err := startLowLevelHook(co.WH_MOUSE_LL,
+		func(code int32, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+			if co.HC(code) == co.HC_ACTION {
+				select {
+				case ch <- MouseHookEvent{
+					Msg:  co.WM(wParam),
+					Info: *(*win.MSLLHOOKSTRUCT)(unsafe.Pointer(lParam)),
+				}:
+				default: // buffer full, drop the event
+				}
+			}
+			return win.HHOOK(0).CallNextHookEx(code, wParam, lParam)
+		}, func() { close(ch) })
+	if err != nil {
+		return nil, nil, err
+	}
+	return hook, ch, nil
+}
+
+// Starts the hook thread, and waits until the hook is installed. The onExit
+// function, if any, is called in the hook thread after the hook is removed.
+func startLowLevelHook(
+	idHook co.WH,
+	proc func(code int32, wParam win.WPARAM, lParam win.LPARAM) uintptr,
+	onExit func(),
+) (*LowLevelHook, error) {
+	me := &LowLevelHook{done: make(chan struct{})}
+	chErr := make(chan error)
+
+	go func() {
+		runtime.LockOSThread() // the hook procedure is called in the thread which installed it
+		defer runtime.UnlockOSThread()
+		defer close(me.done)
+		if onExit != nil {
+			defer onExit()
+		}
+
+		hInst, _ := win.GetModuleHandle("")
+		hHook, err := win.SetWindowsHookEx(idHook, proc, hInst, 0)
+		if err != nil {
+			chErr <- err
+			return
+		}
+		defer hHook.UnhookWindowsHookEx()
+
+		me.threadId = win.GetCurrentThreadId()
+		chErr <- nil
+
+		vecMsg := win.NewVecSized(1, win.MSG{})
+		defer vecMsg.Free()
+		pMsg := vecMsg.Get(0) // OS-allocated
+
+		for { // the hook procedure is called while the thread waits for messages
+			if res, err := win.GetMessage(pMsg, win.HWND(0), 0, 0); err != nil || res == 0 {
+				return // WM_QUIT posted by Stop()
+			}
+		}
+	}()
+
+	if err := <-chErr; err != nil {
+		return nil, err
+	}
+	return me, nil
+}
+
+// Removes the hook and terminates its thread, waiting until it's done.
+//
+// Calling it more than once has no effect.
+func (me *LowLevelHook) Stop() {
+	select {
+	case <-me.done:
+		return // already stopped
+	default:
+	}
+
+	win.PostThreadMessage(me.threadId, co.WM_QUIT, 0, 0)
+	<-me.done
+}
@@ -0,0 +1,465 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// A menu whose items are declared in code, each one running a closure when
+// clicked. The command IDs are assigned automatically.
+//
+// A menu bar is created with [NewMenuBar] and set to a window with [SetMenu];
+// a popup menu is created with [NewMenu], and can be added as a submenu or
+// shown as a context menu.
+type Menu struct {
+	hMenu  win.HMENU
+	parent *Menu             // Menu which contains this one as a submenu, if any.
+	items  []*MenuItem       // Direct items, in order.
+	owners []*_BaseContainer // Windows which dispatch the commands; top-level menu only.
+}
+
+// Creates a new menu bar, with [CreateMenu], to be set to a window with
+// [SetMenu].
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	mnuFile := ui.NewMenu()
+//	mnuFile.AddItem("&Open...", "Ctrl+O", func() {
+//		println("Open")
+//	})
+//	mnuFile.AddSeparator()
+//	mnuFile.AddItem("E&xit", "", func() {
+//		wnd.Hwnd().SendMessage(co.WM_CLOSE, 0, 0)
+//	})
+//
+//	mnuBar := ui.NewMenuBar()
+//	mnuBar.AddSubmenu("&File", mnuFile)
+//	ui.SetMenu(wnd, mnuBar)
+//
+// [CreateMenu]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createmenu
+func NewMenuBar() *Menu {
+	hMenu, err := win.CreateMenu()
+	if err != nil {
+		panic(err)
+	}
+	return &Menu{hMenu: hMenu}
+}
+
+// Creates a new popup menu, with [CreatePopupMenu], to be added as a submenu,
+// or shown with [Menu.ShowAtPoint].
+//
+// A popup menu which is not a submenu must be destroyed with [Menu.Destroy].
+//
+// [CreatePopupMenu]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createpopupmenu
+func NewMenu() *Menu {
+	hMenu, err := win.CreatePopupMenu()
+	if err != nil {
+		panic(err)
+	}
+	return &Menu{hMenu: hMenu}
+}
+
+// Sets the menu bar of the window, and dispatches the commands of its items,
+// including those of the accelerators with the same command IDs.
+//
+// Menus set to a window are destroyed along with the window.
+//
+// Panics if the menu is a submenu.
+func SetMenu(parent Parent, menu *Menu) {
+	if menu.parent != nil {
+		panic("Cannot set a submenu as a window menu.")
+	}
+	menu.attach(parent.base())
+
+	apply := func() {
+		parent.Hwnd().SetMenu(menu.hMenu)
+		parent.Hwnd().DrawMenuBar()
+	}
+
+	if parent.Hwnd() != 0 {
+		apply()
+	} else {
+		parent.base().afterUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+			apply()
+			return 0 // ignored
+		})
+	}
+}
+
+// Adds a check item, whose check mark is toggled when clicked, before fun is
+// called with the new state.
+//
+// The shortcut is displayed right-aligned, and it's only informative; to make
+// it work, use [AddAcceleratorCmd] with the item's command ID.
+func (me *Menu) AddCheckItem(text, shortcut string, checked bool, fun func(checked bool)) *MenuItem {
+	item := &MenuItem{menu: me, text: text, shortcut: shortcut}
+	item.onClick = func() {
+		checked := !item.IsChecked()
+		item.Check(checked)
+		if fun != nil {
+			fun(checked)
+		}
+	}
+	me.insert(item, co.MFT_STRING, checkedState(checked))
+	return item
+}
+
+// Adds an item which calls fun when clicked.
+//
+// The shortcut is displayed right-aligned, and it's only informative; to make
+// it work, use [AddAcceleratorCmd] with the item's command ID.
+func (me *Menu) AddItem(text, shortcut string, fun func()) *MenuItem {
+	item := &MenuItem{menu: me, text: text, shortcut: shortcut, onClick: fun}
+	me.insert(item, co.MFT_STRING, co.MFS_ENABLED)
+	return item
+}
+
+// Adds a group of radio items, one for each text, with the given zero-based
+// index initially selected. When an item is clicked, it becomes the only
+// selected one, then fun is called with its index.
+//
+// Panics if no texts are given.
+func (me *Menu) AddRadioItems(selected int, fun func(index int), texts ...string) []*MenuItem {
+	if len(texts) == 0 {
+		panic("Radio items must have at least one text.")
+	}
+
+	group := make([]*MenuItem, len(texts))
+	for i, text := range texts {
+		index := i
+		item := &MenuItem{menu: me, text: text, radioGroup: group}
+		item.onClick = func() {
+			item.Check(true)
+			if fun != nil {
+				fun(index)
+			}
+		}
+		group[i] = item
+		me.insert(item, co.MFT_STRING|co.MFT_RADIOCHECK, checkedState(i == selected))
+	}
+	return group
+}
+
+// Adds a separator.
+func (me *Menu) AddSeparator() {
+	var mii win.MENUITEMINFO
+	mii.SetCbSize()
+	mii.FMask = co.MIIM_FTYPE
+	mii.FType = co.MFT_SEPARATOR
+	if err := me.hMenu.InsertMenuItemByPos(me.itemCount(), &mii); err != nil {
+		panic(err)
+	}
+}
+
+// Adds a popup menu as a submenu, returning the item which opens it.
+//
+// Panics if the submenu was already added to another menu, or if it's a menu
+// bar.
+func (me *Menu) AddSubmenu(text string, sub *Menu) *MenuItem {
+	if sub.parent != nil {
+		panic("Submenu already added to another menu.")
+	}
+	if len(sub.owners) > 0 {
+		panic("Cannot add a window menu as a submenu.")
+	}
+
+	sub.parent = me
+	item := &MenuItem{menu: me, text: text, submenu: sub}
+
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	var mii win.MENUITEMINFO
+	mii.SetCbSize()
+	mii.FMask = co.MIIM_STRING | co.MIIM_SUBMENU
+	mii.HSubMenu = sub.hMenu
+	mii.DwTypeData = (*uint16)(wbuf.PtrAllowEmpty(text))
+	if err := me.hMenu.InsertMenuItemByPos(me.itemCount(), &mii); err != nil {
+		panic(err)
+	}
+	me.items = append(me.items, item)
+
+	for _, owner := range me.top().owners {
+		sub.register(owner)
+	}
+	return item
+}
+
+// Destroys the menu with [DestroyMenu], along with its submenus. Only needed
+// for popup menus which were neither set to a window, nor added as a submenu.
+//
+// [DestroyMenu]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-destroymenu
+func (me *Menu) Destroy() {
+	if me.hMenu != 0 {
+		me.hMenu.DestroyMenu()
+		me.hMenu = win.HMENU(0)
+	}
+}
+
+// Returns the underlying HMENU handle.
+func (me *Menu) Hmenu() win.HMENU {
+	return me.hMenu
+}
+
+// Returns the direct items of the menu, in order, not counting the
+// separators.
+func (me *Menu) Items() []*MenuItem {
+	return me.items
+}
+
+// Shows the menu as a context menu, with [HMENU.TrackPopupMenu], calling the
+// closure of the chosen item, if any.
+//
+// If hCoordsRelativeTo is zero, coordinates must be relative to the parent.
+//
+// This method will block until the menu disappears.
+func (me *Menu) ShowAtPoint(parent Parent, pos win.POINT, hCoordsRelativeTo win.HWND) {
+	hParent := parent.Hwnd()
+	if hCoordsRelativeTo == 0 {
+		hCoordsRelativeTo = hParent
+	}
+
+	hCoordsRelativeTo.ClientToScreenPt(&pos) // now relative to screen
+	hParent.SetForegroundWindow()
+	cmdId, _ := me.hMenu.TrackPopupMenu(co.TPM_LEFTBUTTON|co.TPM_RETURNCMD|co.TPM_NONOTIFY,
+		int(pos.X), int(pos.Y), hParent)
+	hParent.PostMessage(co.WM_NULL, 0, 0) // necessary according to TrackMenuPopup docs
+
+	if cmdId != 0 {
+		if item := me.findByCmd(uint16(cmdId)); item != nil && item.onClick != nil {
+			item.onClick()
+		}
+	}
+}
+
+// Registers the commands of all items in the window, which becomes an owner of
+// the menu.
+func (me *Menu) attach(owner *_BaseContainer) {
+	me.owners = append(me.owners, owner)
+	me.register(owner)
+}
+
+// Searches the item with the given command ID, in this menu and its submenus.
+func (me *Menu) findByCmd(cmdId uint16) *MenuItem {
+	for _, item := range me.items {
+		if item.submenu != nil {
+			if found := item.submenu.findByCmd(cmdId); found != nil {
+				return found
+			}
+		} else if item.cmdId == cmdId {
+			return item
+		}
+	}
+	return nil
+}
+
+// Inserts a command item at the end of the menu, assigning it a command ID.
+func (me *Menu) insert(item *MenuItem, fType co.MFT, fState co.MFS) {
+	item.cmdId = nextCtrlId()
+
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	var mii win.MENUITEMINFO
+	mii.SetCbSize()
+	mii.FMask = co.MIIM_FTYPE | co.MIIM_ID | co.MIIM_STATE | co.MIIM_STRING
+	mii.FType = fType
+	mii.FState = fState
+	mii.WId = uint32(item.cmdId)
+	mii.DwTypeData = (*uint16)(wbuf.PtrAllowEmpty(item.fullText()))
+	if err := me.hMenu.InsertMenuItemByPos(me.itemCount(), &mii); err != nil {
+		panic(err)
+	}
+	me.items = append(me.items, item)
+
+	for _, owner := range me.top().owners {
+		item.register(owner)
+	}
+}
+
+// Returns the number of items, including the separators.
+func (me *Menu) itemCount() uint {
+	count, _ := me.hMenu.GetMenuItemCount()
+	return count
+}
+
+// Registers the commands of all items of this menu and its submenus.
+func (me *Menu) register(owner *_BaseContainer) {
+	for _, item := range me.items {
+		if item.submenu != nil {
+			item.submenu.register(owner)
+		} else {
+			item.register(owner)
+		}
+	}
+}
+
+// Returns the top-level menu.
+func (me *Menu) top() *Menu {
+	m := me
+	for m.parent != nil {
+		m = m.parent
+	}
+	return m
+}
+
+// An item of a [Menu], which can be a command item, a check item, a radio item
+// or a submenu.
+type MenuItem struct {
+	menu       *Menu
+	cmdId      uint16 // Zero for submenus.
+	text       string
+	shortcut   string
+	onClick    func()
+	radioGroup []*MenuItem // Items of the radio group, if a radio item.
+	submenu    *Menu       // If a submenu, the popup menu it opens.
+}
+
+// Sets the check mark of the item. If it's a radio item, the other items of
+// its group are unchecked.
+//
+// Returns the same object, so further operations can be chained.
+func (me *MenuItem) Check(check bool) *MenuItem {
+	if check && me.radioGroup != nil {
+		for _, other := range me.radioGroup {
+			if other != me {
+				other.setState(co.MFS_CHECKED, false)
+			}
+		}
+	}
+	me.setState(co.MFS_CHECKED, check)
+	return me
+}
+
+// Returns the command ID of the item, which is zero for submenus.
+func (me *MenuItem) CmdId() uint16 {
+	return me.cmdId
+}
+
+// Enables or disables the item.
+//
+// Returns the same object, so further operations can be chained.
+func (me *MenuItem) Enable(enable bool) *MenuItem {
+	me.setState(co.MFS_DISABLED, !enable)
+	return me
+}
+
+// Returns true if the item has a check mark.
+func (me *MenuItem) IsChecked() bool {
+	return (me.state() & co.MFS_CHECKED) != 0
+}
+
+// Returns true if the item is enabled.
+func (me *MenuItem) IsEnabled() bool {
+	return (me.state() & co.MFS_DISABLED) == 0
+}
+
+// Makes this item the default one of its menu, which is displayed in bold.
+//
+// Returns the same object, so further operations can be chained.
+func (me *MenuItem) SetDefault() *MenuItem {
+	if err := me.menu.hMenu.SetMenuDefaultItemByPos(me.pos()); err != nil {
+		panic(err)
+	}
+	return me
+}
+
+// Sets the text of the item, keeping its shortcut text.
+//
+// Returns the same object, so further operations can be chained.
+func (me *MenuItem) SetText(text string) *MenuItem {
+	me.text = text
+
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	var mii win.MENUITEMINFO
+	mii.SetCbSize()
+	mii.FMask = co.MIIM_STRING
+	mii.DwTypeData = (*uint16)(wbuf.PtrAllowEmpty(me.fullText()))
+	if err := me.menu.hMenu.SetMenuItemInfoByPos(me.pos(), &mii); err != nil {
+		panic(err)
+	}
+	return me
+}
+
+// Returns the submenu opened by this item, if any.
+func (me *MenuItem) Submenu() (*Menu, bool) {
+	return me.submenu, me.submenu != nil
+}
+
+// Returns the text of the item, without the shortcut text.
+func (me *MenuItem) Text() string {
+	return me.text
+}
+
+// Returns the text with the shortcut, separated by a tab.
+func (me *MenuItem) fullText() string {
+	if me.shortcut == "" {
+		return me.text
+	}
+	return me.text + "\t" + me.shortcut
+}
+
+// Returns the zero-based position of the item, counting the separators.
+func (me *MenuItem) pos() uint {
+	count := me.menu.itemCount()
+	for i := uint(0); i < count; i++ {
+		if me.submenu != nil {
+			if hSub, ok := me.menu.hMenu.GetSubMenu(i); ok && hSub == me.submenu.hMenu {
+				return i
+			}
+		} else if id, _ := me.menu.hMenu.GetMenuItemID(i); id == me.cmdId {
+			return i
+		}
+	}
+	panic("Menu item not found.")
+}
+
+// Registers the command of the item in the window, for both menu and
+// accelerator notifications.
+func (me *MenuItem) register(owner *_BaseContainer) {
+	if me.onClick != nil {
+		owner.beforeUserEvents.WmCommandAccelMenu(me.cmdId, me.onClick)
+	}
+}
+
+// Sets or clears the given state flag of the item.
+func (me *MenuItem) setState(flag co.MFS, set bool) {
+	newState := me.state() &^ flag
+	if set {
+		newState |= flag
+	}
+
+	var mii win.MENUITEMINFO
+	mii.SetCbSize()
+	mii.FMask = co.MIIM_STATE
+	mii.FState = newState
+	if err := me.menu.hMenu.SetMenuItemInfoByPos(me.pos(), &mii); err != nil {
+		panic(err)
+	}
+}
+
+// Retrieves the current state flags of the item.
+func (me *MenuItem) state() co.MFS {
+	var mii win.MENUITEMINFO
+	mii.SetCbSize()
+	mii.FMask = co.MIIM_STATE
+	if err := me.menu.hMenu.GetMenuItemInfoByPos(me.pos(), &mii); err != nil {
+		panic(err)
+	}
+	return mii.FState
+}
+
+// Returns MFS_CHECKED if checked, otherwise MFS_UNCHECKED.
+func checkedState(checked bool) co.MFS {
+	if checked {
+		return co.MFS_CHECKED
+	}
+	return co.MFS_UNCHECKED
+}
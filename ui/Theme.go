@@ -0,0 +1,192 @@
+//go:build windows
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Color theme of the application, set with [SetTheme].
+type THEME uint8
+
+const (
+	// Default. The windows and controls have the ordinary light colors.
+	THEME_LIGHT THEME = iota
+	// The windows and controls have dark colors.
+	THEME_DARK
+	// Follows the app mode chosen in the Windows settings, changing the
+	// colors whenever the user changes it.
+	THEME_SYSTEM
+)
+
+var (
+	globalTheme       THEME                                = THEME_LIGHT
+	globalThemeDark   bool                                 // Whether the dark colors are currently in use.
+	globalThemedWnds  = make(map[*_BaseContainer]struct{}) // Created windows, which receive the theme changes.
+	globalDarkBrushes [2]win.HBRUSH                        // Background of windows and of editable controls, created on demand.
+)
+
+// Sets the color theme of the application, which is applied to all windows
+// and to the following controls:
+//   - the caption of top-level windows;
+//   - [Button], [CheckBox], [RadioButton] and [Static];
+//   - [ComboBox] and [Edit];
+//   - [ListView], its [Header], and [TreeView];
+//   - [ScrollBar], and the scroll bars of the windows.
+//
+// Can be called before or after the windows are created; controls created
+// later are themed too. Colors set by the user – in list views, tree views, or
+// by handling WM_CTLCOLOR messages – are kept. With THEME_SYSTEM, the app mode
+// is read from the AppsUseLightTheme registry value.
+//
+// The dark captions require Windows 10 version 2004 or later. The dark
+// controls use undocumented themes, available on Windows 10 version 1809 and
+// later; before that, the controls keep their light appearance.
+//
+// # Example
+//
+//	ui.SetTheme(ui.THEME_SYSTEM)
+//
+//	wnd := ui.NewMain(ui.OptsMain())
+//	wnd.RunAsMain()
+func SetTheme(theme THEME) {
+	globalTheme = theme
+	refreshTheme()
+}
+
+// Returns true if the dark colors are currently in use, either because
+// THEME_DARK was set, or because THEME_SYSTEM was set and the app mode is dark.
+func IsDarkTheme() bool {
+	return globalThemeDark
+}
+
+// Checks whether the dark colors should be used, and applies them to all the
+// windows, if they changed.
+func refreshTheme() {
+	dark := globalTheme == THEME_DARK ||
+		(globalTheme == THEME_SYSTEM && systemUsesDarkTheme())
+	if dark != globalThemeDark {
+		globalThemeDark = dark
+		for wnd := range globalThemedWnds {
+			wnd.applyTheme()
+		}
+	}
+}
+
+// Reads the app mode chosen in the Windows settings. If the registry value
+// doesn't exist, the light mode is assumed.
+func systemUsesDarkTheme() bool {
+	regVal, err := win.HKEY_CURRENT_USER.RegGetValue(
+		"Software\\Microsoft\\Windows\\CurrentVersion\\Themes\\Personalize",
+		"AppsUseLightTheme",
+		co.RRF_RT_REG_DWORD,
+	)
+	if err != nil {
+		return false
+	}
+	useLight, _ := regVal.Dword()
+	return useLight == 0
+}
+
+// Returns the background and text colors of the dark theme. Editable controls
+// have a lighter background.
+func darkColors(editable bool) (bk, text win.COLORREF) {
+	if editable {
+		return win.RGB(43, 43, 43), win.RGB(240, 240, 240)
+	}
+	return win.RGB(32, 32, 32), win.RGB(240, 240, 240)
+}
+
+// Returns the brush with the background of the dark theme, creating it if
+// needed.
+func darkBrush(editable bool) win.HBRUSH {
+	idx := 0
+	if editable {
+		idx = 1
+	}
+	if globalDarkBrushes[idx] == 0 {
+		bk, _ := darkColors(editable)
+		globalDarkBrushes[idx], _ = win.CreateSolidBrush(bk)
+	}
+	return globalDarkBrushes[idx]
+}
+
+// Deletes the brushes of the dark theme.
+func deleteGlobalDarkBrushes() {
+	for i, hBrush := range globalDarkBrushes {
+		if hBrush != 0 {
+			hBrush.DeleteObject()
+			globalDarkBrushes[i] = 0
+		}
+	}
+}
+
+// Applies the current theme to a child control, according to its class.
+func applyCtrlTheme(hCtrl win.HWND) {
+	className, _ := hCtrl.GetClassName()
+	switch {
+	case strings.EqualFold(className, "SysListView32"):
+		setCtrlTheme(hCtrl, "DarkMode_Explorer")
+		bk, text := ctrlColors()
+		setCtrlColor(hCtrl, co.LVM_GETBKCOLOR, co.LVM_SETBKCOLOR, bk, win.COLORREF_DEFAULT)
+		setCtrlColor(hCtrl, co.LVM_GETTEXTBKCOLOR, co.LVM_SETTEXTBKCOLOR, bk, win.COLORREF_DEFAULT)
+		setCtrlColor(hCtrl, co.LVM_GETTEXTCOLOR, co.LVM_SETTEXTCOLOR, text, win.COLORREF_DEFAULT)
+		if hHeader, _ := hCtrl.SendMessage(co.LVM_GETHEADER, 0, 0); hHeader != 0 {
+			setCtrlTheme(win.HWND(hHeader), "DarkMode_ItemsView")
+		}
+	case strings.EqualFold(className, "SysTreeView32"):
+		setCtrlTheme(hCtrl, "DarkMode_Explorer")
+		bk, text := ctrlColors() // tree views return -1 when using the system colors
+		setCtrlColor(hCtrl, co.TVM_GETBKCOLOR, co.TVM_SETBKCOLOR, bk, win.COLORREF_NONE)
+		setCtrlColor(hCtrl, co.TVM_GETTEXTCOLOR, co.TVM_SETTEXTCOLOR, text, win.COLORREF_NONE)
+	case strings.EqualFold(className, "Edit"),
+		strings.EqualFold(className, "ComboBox"):
+		setCtrlTheme(hCtrl, "DarkMode_CFD")
+	case strings.EqualFold(className, "Button"):
+		style, _ := hCtrl.Style()
+		switch co.BS(style) & co.BS_TYPEMASK {
+		case co.BS_PUSHBUTTON, co.BS_DEFPUSHBUTTON:
+			setCtrlTheme(hCtrl, "DarkMode_Explorer")
+		default:
+			// The themed check boxes, radio buttons and group boxes ignore the
+			// text color, so the visual styles are disabled.
+			setCtrlTheme(hCtrl, " ")
+		}
+	case strings.EqualFold(className, "ScrollBar"):
+		setCtrlTheme(hCtrl, "DarkMode_Explorer")
+	}
+}
+
+// Sets the given theme to the control if the dark colors are in use; otherwise
+// restores its default theme.
+func setCtrlTheme(hCtrl win.HWND, darkTheme string) {
+	if globalThemeDark {
+		hCtrl.SetWindowTheme(darkTheme, "")
+	} else {
+		hCtrl.SetWindowTheme("", "")
+	}
+}
+
+// Sets a color of a list view or tree view, but only if the current one is the
+// default color or was set by a theme, so colors set by the user are kept.
+func setCtrlColor(hCtrl win.HWND, msgGet, msgSet co.WM, color, defColor win.COLORREF) {
+	curRet, _ := hCtrl.SendMessage(msgGet, 0, 0)
+	darkBk, darkText := darkColors(false)
+	switch win.COLORREF(uint32(curRet)) {
+	case defColor, darkBk, darkText,
+		win.GetSysColor(co.COLOR_WINDOW), win.GetSysColor(co.COLOR_WINDOWTEXT):
+		hCtrl.SendMessage(msgSet, 0, win.LPARAM(color))
+	}
+}
+
+// Returns the background and text colors for list views and tree views,
+// according to the current theme.
+func ctrlColors() (bk, text win.COLORREF) {
+	if globalThemeDark {
+		return darkColors(false)
+	}
+	return win.GetSysColor(co.COLOR_WINDOW), win.GetSysColor(co.COLOR_WINDOWTEXT)
+}
@@ -0,0 +1,266 @@
+//go:build windows
+
+package ui
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+var globalNextTimerId uintptr = 0x7fff_ffff
+
+// Returns an unique timer ID.
+func nextTimerId() uintptr {
+	nextId := globalNextTimerId
+	globalNextTimerId-- // go down
+	return nextId
+}
+
+// A timer which calls a function in the UI thread of its window, created with
+// [Parent.AfterFunc] or [Parent.Every].
+//
+// By default, the timer is implemented with [SetTimer], whose resolution is
+// about 15 milliseconds. A high-resolution timer can be used instead with
+// [Timer.SetHighResolution].
+//
+// The timer is stopped automatically when the window is destroyed.
+//
+// [SetTimer]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-settimer
+type Timer struct {
+	owner    *_BaseContainer
+	id       uintptr
+	interval time.Duration
+	periodic bool
+	hiRes    bool
+	fun      func()
+	running  bool
+	hiResRun *_HiResTimerRun // Goroutine waiting on the high-resolution timer, if any.
+}
+
+func (me *_BaseContainer) newTimer(interval time.Duration, periodic bool, fun func()) *Timer {
+	t := &Timer{
+		owner:    me,
+		id:       nextTimerId(),
+		interval: interval,
+		periodic: periodic,
+		fun:      fun,
+	}
+	t.start()
+	return t
+}
+
+func (me *_BaseContainer) trackTimer(t *Timer) {
+	if me.timers == nil {
+		me.timers = make(map[uintptr]*Timer)
+	}
+
+	if !me.timersHooked { // first timer, install the handlers
+		me.timersHooked = true
+
+		if me.hWnd == 0 {
+			me.afterUserEvents.Wm(me.wndTy.initMsg(), func(_ Wm) uintptr {
+				for _, t := range me.timers {
+					t.arm() // timers started before the window was created
+				}
+				return 0 // ignored
+			})
+		}
+
+		me.beforeUserEvents.Wm(co.WM_TIMER, func(p Wm) uintptr {
+			if t, ok := me.timers[uintptr(p.WParam)]; ok && t.hiResRun == nil {
+				t.fire()
+			}
+			return 0 // ignored
+		})
+
+		me.beforeUserEvents.WmDestroy(func() {
+			for _, t := range me.timers {
+				t.Stop() // also removes it from the map
+			}
+		})
+	}
+
+	me.timers[t.id] = t
+}
+
+// Starts the timer, which is armed right away if the window already exists.
+func (me *Timer) start() {
+	me.running = true
+	me.owner.trackTimer(me)
+	if me.owner.hWnd != 0 {
+		me.arm()
+	}
+}
+
+func (me *Timer) arm() {
+	if me.hiRes {
+		if me.hiResRun = startHiResTimerRun(me); me.hiResRun != nil {
+			return
+		} // otherwise high-resolution timers are not supported, fall back
+	}
+
+	ms := me.interval.Milliseconds()
+	if ms > 0x7fff_ffff {
+		ms = 0x7fff_ffff
+	}
+	if _, err := me.owner.hWnd.SetTimer(me.id, int(ms)); err != nil {
+		panic(err)
+	}
+}
+
+func (me *Timer) disarm() {
+	if me.hiResRun != nil {
+		me.hiResRun.stop()
+		me.hiResRun = nil
+	} else if me.owner.hWnd != 0 {
+		me.owner.hWnd.KillTimer(me.id)
+	}
+}
+
+// Called in the UI thread when the timer elapses.
+func (me *Timer) fire() {
+	if !me.periodic {
+		me.Stop()
+	}
+	me.fun()
+}
+
+// Returns true if the timer is running.
+func (me *Timer) IsRunning() bool {
+	return me.running
+}
+
+// Restarts the timer with a new interval, no matter if it was stopped or not.
+// A one-shot timer can be reused this way.
+//
+// Returns true if the timer was running.
+func (me *Timer) Reset(interval time.Duration) bool {
+	wasRunning := me.Stop()
+	me.interval = interval
+	me.start()
+	return wasRunning
+}
+
+// Uses a high-resolution [waitable timer] instead of [SetTimer], restarting the
+// timer if it's running. The function is still called in the UI thread.
+//
+// High-resolution timers require Windows 10 version 1803; in older systems,
+// [SetTimer] is used anyway.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	wnd.Every(5*time.Millisecond, func() {
+//		println("Tick")
+//	}).SetHighResolution(true)
+//
+// [waitable timer]: https://learn.microsoft.com/en-us/windows/win32/sync/waitable-timer-objects
+// [SetTimer]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-settimer
+func (me *Timer) SetHighResolution(hiRes bool) *Timer {
+	if hiRes != me.hiRes {
+		me.hiRes = hiRes
+		if me.running && me.owner.hWnd != 0 {
+			me.disarm()
+			me.arm()
+		}
+	}
+	return me
+}
+
+// Stops the timer, so the function won't be called anymore.
+//
+// Returns true if the timer was running.
+func (me *Timer) Stop() bool {
+	if !me.running {
+		return false
+	}
+	me.disarm()
+	me.running = false
+	delete(me.owner.timers, me.id)
+	return true
+}
+
+// A goroutine waiting on a high-resolution waitable timer, which calls the
+// function in the UI thread. The handle is closed by the goroutine itself, once
+// it sees the stopped flag.
+type _HiResTimerRun struct {
+	mutex   sync.Mutex
+	hTimer  win.HWAITABLETIMER
+	stopped bool
+}
+
+// Creates the waitable timer and starts the goroutine; returns nil if
+// high-resolution timers are not supported.
+func startHiResTimerRun(t *Timer) *_HiResTimerRun {
+	hTimer, err := win.CreateWaitableTimerEx(nil, "",
+		co.CREATE_WAITABLE_TIMER_HIGH_RESOLUTION, co.TIMER_ALL_ACCESS)
+	if err != nil {
+		return nil
+	}
+
+	run := &_HiResTimerRun{hTimer: hTimer}
+	owner, interval := t.owner, t.interval
+
+	go func() {
+		defer hTimer.CloseHandle()
+		next := time.Now()
+
+		for {
+			next = next.Add(interval) // absolute deadlines, so periodic timers don't drift
+			if !run.rearm(time.Until(next)) {
+				return
+			}
+			hTimer.WaitForSingleObjectInfinite()
+			if run.isStopped() {
+				return
+			}
+
+			owner.uiThread(func() {
+				if t.hiResRun == run { // not stopped or reset in the meantime
+					t.fire()
+				}
+			})
+		}
+	}()
+
+	return run
+}
+
+func (me *_HiResTimerRun) isStopped() bool {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	return me.stopped
+}
+
+// Sets the timer to be signaled after the given time; returns false if the run
+// has been stopped.
+func (me *_HiResTimerRun) rearm(after time.Duration) bool {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	if me.stopped {
+		return false
+	}
+	if after < 100 {
+		after = 100 // we're late, signal right away
+	}
+	me.hTimer.SetWaitableTimer(-int64(after/100), 0) // relative, in 100-nanosecond intervals
+	return true
+}
+
+// Flags the run as stopped, and wakes up the goroutine so it can quit.
+func (me *_HiResTimerRun) stop() {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	if !me.stopped {
+		me.stopped = true
+		me.hTimer.SetWaitableTimer(-1, 0)
+	}
+}
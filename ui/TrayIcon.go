@@ -0,0 +1,292 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+const _WM_UI_TRAY = co.WM_APP + 0x3ffe // Internal callback message of the tray icons.
+
+// An icon in the notification area of the taskbar, with [Shell_NotifyIcon].
+//
+// The icon is added when the parent window is created, removed when it's
+// destroyed, and added again automatically if Explorer restarts.
+//
+// [Shell_NotifyIcon]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+type TrayIcon struct {
+	parent  Parent
+	id      uint16
+	hIcon   win.HICON
+	tooltip string
+	menu    *Menu
+	events  EventsTrayIcon
+}
+
+// Creates a new [TrayIcon], whose notifications are received by the parent
+// window, which must be a top-level one.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//	var hIcon win.HICON
+//
+//	mnu := ui.NewMenu()
+//	mnu.AddItem("E&xit", "", func() {
+//		wnd.Hwnd().SendMessage(co.WM_CLOSE, 0, 0)
+//	})
+//
+//	tray := ui.NewTrayIcon(
+//		wnd,
+//		ui.OptsTrayIcon().
+//			Icon(hIcon).
+//			Tooltip("My app").
+//			ContextMenu(mnu),
+//	)
+//	tray.On().Click(func() {
+//		wnd.Hwnd().ShowWindow(co.SW_RESTORE)
+//	})
+func NewTrayIcon(parent Parent, opts *VarOptsTrayIcon) *TrayIcon {
+	me := &TrayIcon{
+		parent:  parent,
+		id:      nextCtrlId(),
+		hIcon:   opts.hIcon,
+		tooltip: opts.tooltip,
+		menu:    opts.menu,
+	}
+
+	if parent.Hwnd() != 0 {
+		me.add()
+	} else {
+		parent.base().afterUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+			me.add()
+			return 0 // ignored
+		})
+	}
+
+	parent.base().beforeUserEvents.Wm(_WM_UI_TRAY, func(p Wm) uintptr {
+		if p.LParam.HiWord() == me.id {
+			me.processNotification(p)
+		}
+		return 0 // ignored
+	})
+
+	wmTaskbarCreated, _ := win.RegisterWindowMessage("TaskbarCreated")
+	parent.base().beforeUserEvents.Wm(wmTaskbarCreated, func(_ Wm) uintptr {
+		me.add() // Explorer restarted, our icon is gone
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.WmDestroy(func() {
+		me.Remove()
+		if me.menu != nil {
+			me.menu.Destroy()
+		}
+	})
+
+	return me
+}
+
+// Adds the icon to the notification area.
+func (me *TrayIcon) add() {
+	nid := me.newNid(co.NIF_MESSAGE | co.NIF_ICON | co.NIF_TIP | co.NIF_SHOWTIP)
+	nid.UCallbackMessage = _WM_UI_TRAY
+	nid.HIcon = me.hIcon
+	nid.SetSzTip(me.tooltip)
+	win.Shell_NotifyIcon(co.NIM_ADD, &nid) // may fail if the taskbar is not running
+
+	nid.SetUVersion(co.NOTIFYICON_VERSION_4)
+	win.Shell_NotifyIcon(co.NIM_SETVERSION, &nid)
+}
+
+// Returns a NOTIFYICONDATA which identifies this icon.
+func (me *TrayIcon) newNid(flags co.NIF) win.NOTIFYICONDATA {
+	var nid win.NOTIFYICONDATA
+	nid.SetCbSize()
+	nid.HWnd = me.parent.Hwnd()
+	nid.UID = uint32(me.id)
+	nid.UFlags = flags
+	return nid
+}
+
+func (me *TrayIcon) processNotification(p Wm) {
+	switch notif := p.LParam.LoWord(); {
+	case co.NIN(notif) == co.NIN_SELECT || co.NIN(notif) == co.NIN_KEYSELECT:
+		if me.events.click != nil {
+			me.events.click()
+		}
+	case co.WM(notif) == co.WM_LBUTTONDBLCLK:
+		if me.events.dblClick != nil {
+			me.events.dblClick()
+		}
+	case co.WM(notif) == co.WM_CONTEXTMENU:
+		pos := win.POINT{ // screen coordinates of the icon
+			X: int32(int16(p.WParam.LoWord())),
+			Y: int32(int16(p.WParam.HiWord())),
+		}
+		if me.events.rightClick != nil {
+			me.events.rightClick(pos)
+		}
+		if me.menu != nil {
+			me.parent.Hwnd().ScreenToClientPt(&pos)
+			me.menu.ShowAtPoint(me.parent, pos, win.HWND(0))
+		}
+	case co.NIN(notif) == co.NIN_BALLOONUSERCLICK:
+		if me.events.balloonClick != nil {
+			me.events.balloonClick()
+		}
+	}
+}
+
+// Returns the context menu shown when the icon is right-clicked, if any.
+func (me *TrayIcon) ContextMenu() *Menu {
+	return me.menu
+}
+
+// Returns the icon currently displayed.
+func (me *TrayIcon) Hicon() win.HICON {
+	return me.hIcon
+}
+
+// Exposes the notifications of the icon which can be handled.
+func (me *TrayIcon) On() *EventsTrayIcon {
+	return &me.events
+}
+
+// Removes the icon from the notification area, with [Shell_NotifyIcon].
+//
+// This is done automatically when the parent window is destroyed.
+//
+// [Shell_NotifyIcon]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *TrayIcon) Remove() {
+	nid := me.newNid(co.NIF(0))
+	win.Shell_NotifyIcon(co.NIM_DELETE, &nid)
+}
+
+// Sets the context menu shown when the icon is right-clicked. The menu is
+// destroyed along with the parent window.
+//
+// Returns the same object, so further operations can be chained.
+func (me *TrayIcon) SetContextMenu(menu *Menu) *TrayIcon {
+	me.menu = menu
+	return me
+}
+
+// Sets the icon being displayed.
+//
+// The icon is not destroyed by the [TrayIcon].
+//
+// Returns the same object, so further operations can be chained.
+func (me *TrayIcon) SetIcon(hIcon win.HICON) *TrayIcon {
+	me.hIcon = hIcon
+	nid := me.newNid(co.NIF_ICON)
+	nid.HIcon = hIcon
+	win.Shell_NotifyIcon(co.NIM_MODIFY, &nid)
+	return me
+}
+
+// Sets the tooltip displayed when the mouse hovers the icon. It's truncated
+// at 127 characters.
+//
+// Returns the same object, so further operations can be chained.
+func (me *TrayIcon) SetTooltip(text string) *TrayIcon {
+	me.tooltip = text
+	nid := me.newNid(co.NIF_TIP | co.NIF_SHOWTIP)
+	nid.SetSzTip(text)
+	win.Shell_NotifyIcon(co.NIM_MODIFY, &nid)
+	return me
+}
+
+// Shows a balloon notification coming from the icon; on Windows 10 and later,
+// it's shown as a toast notification. The icon can be co.NIIF_NONE,
+// co.NIIF_INFO, co.NIIF_WARNING, co.NIIF_ERROR or co.NIIF_USER, which uses the
+// tray icon itself, optionally combined with other NIIF flags, like
+// co.NIIF_NOSOUND.
+//
+// The title is truncated at 63 characters, and the text at 255.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var tray *ui.TrayIcon // initialized somewhere
+//
+//	tray.On().BalloonClick(func() {
+//		println("Clicked")
+//	})
+//	tray.ShowBalloon("Download", "The file was downloaded.", co.NIIF_INFO)
+func (me *TrayIcon) ShowBalloon(title, text string, icon co.NIIF) *TrayIcon {
+	nid := me.newNid(co.NIF_INFO)
+	nid.SetSzInfoTitle(title)
+	nid.SetSzInfo(text)
+	nid.DwInfoFlags = icon
+	win.Shell_NotifyIcon(co.NIM_MODIFY, &nid)
+	return me
+}
+
+// Returns the tooltip displayed when the mouse hovers the icon.
+func (me *TrayIcon) Tooltip() string {
+	return me.tooltip
+}
+
+// Options for [NewTrayIcon]; returned by [OptsTrayIcon].
+type VarOptsTrayIcon struct {
+	hIcon   win.HICON
+	tooltip string
+	menu    *Menu
+}
+
+// Options for [NewTrayIcon].
+func OptsTrayIcon() *VarOptsTrayIcon {
+	return &VarOptsTrayIcon{}
+}
+
+// Context menu shown when the icon is right-clicked. The menu is destroyed
+// along with the parent window.
+//
+// Defaults to none.
+func (o *VarOptsTrayIcon) ContextMenu(m *Menu) *VarOptsTrayIcon { o.menu = m; return o }
+
+// Icon to be displayed. It's not destroyed by the [TrayIcon].
+//
+// Defaults to none.
+func (o *VarOptsTrayIcon) Icon(h win.HICON) *VarOptsTrayIcon { o.hIcon = h; return o }
+
+// Tooltip displayed when the mouse hovers the icon.
+//
+// Defaults to none.
+func (o *VarOptsTrayIcon) Tooltip(t string) *VarOptsTrayIcon { o.tooltip = t; return o }
+
+// Notifications of a [TrayIcon]. Only the last handler of each notification is
+// called.
+type EventsTrayIcon struct {
+	balloonClick func()
+	click        func()
+	dblClick     func()
+	rightClick   func(pos win.POINT)
+}
+
+// Called when the user clicks a balloon notification shown with
+// [TrayIcon.ShowBalloon].
+func (me *EventsTrayIcon) BalloonClick(fun func()) {
+	me.balloonClick = fun
+}
+
+// Called when the icon is left-clicked, or selected with the keyboard. A
+// double-click also fires this event.
+func (me *EventsTrayIcon) Click(fun func()) {
+	me.click = fun
+}
+
+// Called when the icon is double-clicked.
+func (me *EventsTrayIcon) DblClick(fun func()) {
+	me.dblClick = fun
+}
+
+// Called when the icon is right-clicked, or its context menu is requested with
+// the keyboard, before the context menu is shown. Receives the position of the
+// icon, in screen coordinates.
+func (me *EventsTrayIcon) RightClick(fun func(pos win.POINT)) {
+	me.rightClick = fun
+}
@@ -82,11 +82,16 @@ func (me *_BaseCtrl) createWindow(
 	}
 
 	hInst, _ := parent.Hwnd().HInstance()
+	pos, size = scaleToParentDpi(parent.Hwnd(), pos, size)
 	me.hWnd, _ = win.CreateWindowEx(exStyle, win.ClassNameStr(className),
 		title, style, int(pos.X), int(pos.Y), uint(size.Cx), uint(size.Cy),
 		parent.Hwnd(), win.HMENU(me.ctrlId), hInst, win.LPARAM(0))
 	if setGlobalUiFont {
-		me.hWnd.SendMessage(co.WM_SETFONT, win.WPARAM(globalUiFont), win.LPARAM(1))
+		hFont := uiFontForDpi(windowDpi(parent.Hwnd()))
+		me.hWnd.SendMessage(co.WM_SETFONT, win.WPARAM(hFont), win.LPARAM(1))
+	}
+	if globalThemeDark { // the parent themes only the children it already has
+		applyCtrlTheme(me.hWnd)
 	}
 	me.installSubclass()
 }
@@ -0,0 +1,150 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Interval of the auto-scroll timer, in milliseconds.
+const _ITEMDRAG_SCROLL_MS = 60
+
+// Returns the ID of the auto-scroll timer, set on the parent window, for the
+// given control. The high word keeps it apart from the user timers.
+func itemDragTimerId(ctrlId uint16) uintptr {
+	return uintptr(0xd7a6_0000) | uintptr(ctrlId)
+}
+
+// Mouse capture, drag image and auto-scroll shared by the controls which allow
+// their items to be dragged. While dragging, the parent window captures the
+// mouse, so the control must handle the parent's mouse messages.
+type _ItemDrag struct {
+	hCtrl     win.HWND       // Control whose items are being dragged.
+	hParent   win.HWND       // Window which captures the mouse.
+	hImg      win.HIMAGELIST // Drag image; zero if the control couldn't create one.
+	timerId   uintptr
+	scrollDir int // -1 scrolls up, 1 scrolls down, 0 doesn't scroll.
+	active    bool
+}
+
+// Starts the drag operation, capturing the mouse. The drag image, if any, will
+// be owned and destroyed by this object.
+func (me *_ItemDrag) begin(
+	hCtrl, hParent win.HWND,
+	hImg win.HIMAGELIST,
+	hotspot win.POINT,
+	timerId uintptr,
+) {
+	me.hCtrl = hCtrl
+	me.hParent = hParent
+	me.hImg = hImg
+	me.timerId = timerId
+	me.scrollDir = 0
+	me.active = true
+
+	if me.hImg != win.HIMAGELIST(0) {
+		me.hImg.BeginDrag(0, int(hotspot.X), int(hotspot.Y))
+		pt := me.imagePos()
+		me.hCtrl.ImageListDragEnter(int(pt.X), int(pt.Y))
+	}
+	me.hParent.SetCapture()
+}
+
+// Finishes the drag operation, releasing all resources. Does nothing if there
+// is no drag operation.
+func (me *_ItemDrag) end() {
+	if !me.active {
+		return
+	}
+	me.active = false // releasing the capture will send WM_CAPTURECHANGED
+
+	if me.scrollDir != 0 {
+		me.hParent.KillTimer(me.timerId)
+		me.scrollDir = 0
+	}
+	if me.hImg != win.HIMAGELIST(0) {
+		me.hCtrl.ImageListDragLeave()
+		win.ImageListEndDrag()
+		me.hImg.Destroy()
+		me.hImg = win.HIMAGELIST(0)
+	}
+	if win.GetCapture() == me.hParent {
+		win.ReleaseCapture()
+	}
+}
+
+// Returns the cursor position, relative to the control's client area.
+func (me *_ItemDrag) cursorPos() win.POINT {
+	pt, _ := win.GetCursorPos()
+	me.hCtrl.ScreenToClientPt(&pt)
+	return pt
+}
+
+// Returns the cursor position, relative to the control's window area, as
+// expected by the drag image functions.
+func (me *_ItemDrag) imagePos() win.POINT {
+	pt, _ := win.GetCursorPos()
+	rc, _ := me.hCtrl.GetWindowRect()
+	return win.POINT{X: pt.X - rc.Left, Y: pt.Y - rc.Top}
+}
+
+// Moves the drag image to the cursor position, and sets the cursor according
+// to whether the items can be dropped at this point.
+func (me *_ItemDrag) moveImage(canDrop bool) {
+	if me.hImg != win.HIMAGELIST(0) {
+		pt := me.imagePos()
+		win.ImageListDragMove(int(pt.X), int(pt.Y))
+	}
+
+	idc := co.IDC_NO
+	if canDrop {
+		idc = co.IDC_ARROW
+	}
+	hCursor, _ := win.HINSTANCE(0).LoadCursor(win.CursorResIdc(idc))
+	hCursor.SetCursor()
+}
+
+// Hides or shows the drag image, so the control can be repainted without
+// leaving trails.
+func (me *_ItemDrag) showImage(show bool) {
+	if me.hImg != win.HIMAGELIST(0) {
+		win.ImageListDragShowNolock(show)
+	}
+}
+
+// Starts or stops the auto-scroll timer, according to the cursor being close
+// to the top or bottom edges of the control.
+func (me *_ItemDrag) updateAutoScroll(pt win.POINT) {
+	rc, _ := me.hCtrl.GetClientRect()
+	zone := int32(DpiY(16))
+
+	dir := 0
+	if pt.Y < rc.Top+zone {
+		dir = -1
+	} else if pt.Y >= rc.Bottom-zone {
+		dir = 1
+	}
+
+	if dir != me.scrollDir {
+		me.scrollDir = dir
+		if dir == 0 {
+			me.hParent.KillTimer(me.timerId)
+		} else {
+			me.hParent.SetTimer(me.timerId, _ITEMDRAG_SCROLL_MS)
+		}
+	}
+}
+
+// Scrolls the control by one line, on the auto-scroll timer.
+func (me *_ItemDrag) scrollStep() {
+	req := co.SB_REQ_LINEDOWN
+	if me.scrollDir < 0 {
+		req = co.SB_REQ_LINEUP
+	}
+
+	me.showImage(false)
+	me.hCtrl.SendMessage(co.WM_VSCROLL, win.MAKEWPARAM(uint16(req), 0), 0)
+	me.hCtrl.UpdateWindow()
+	me.showImage(true)
+}
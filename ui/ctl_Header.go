@@ -98,6 +98,14 @@ func (me *Header) assignToListView(hHeader win.HWND) {
 }
 
 func (me *Header) defaultMessageHandlers(parent Parent) {
+	parent.base().onDpiChanged(func(oldDpi, newDpi int) {
+		kinds := []co.HDSIL{co.HDSIL_NORMAL, co.HDSIL_STATE}
+		for _, kind := range kinds {
+			rescaleCtrlImageList(me.hWnd, co.HDM_GETIMAGELIST, co.HDM_SETIMAGELIST,
+				win.WPARAM(kind), oldDpi, newDpi)
+		}
+	})
+
 	parent.base().afterUserEvents.WmDestroy(func() {
 		kinds := []co.HDSIL{co.HDSIL_NORMAL, co.HDSIL_STATE}
 		for _, kind := range kinds {
@@ -0,0 +1,215 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [hot key] control, which lets the user enter a key combination.
+//
+// [hot key]: https://learn.microsoft.com/en-us/windows/win32/controls/hot-key-controls
+type HotKey struct {
+	_BaseCtrl
+	events EventsHotKey
+}
+
+// Creates a new [HotKey] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	hk := ui.NewHotKey(
+//		wndOwner,
+//		ui.OptsHotKey().
+//			Position(ui.Dpi(20, 10)).
+//			HotKey(co.VK('S'), co.HOTKEYF_CONTROL|co.HOTKEYF_SHIFT),
+//	)
+func NewHotKey(parent Parent, opts *VarOptsHotKey) *HotKey {
+	win.InitCommonControlsEx(co.ICC_HOTKEY_CLASS)
+
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &HotKey{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		events:    EventsHotKey{opts.ctrlId, &parent.base().userEvents},
+	}
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		me.createWindow(opts.wndExStyle, "msctls_hotkey32", "",
+			opts.wndStyle, opts.position, opts.size, parent, true)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		if opts.vk != 0 {
+			me.SetHotKey(opts.vk, opts.modifiers)
+		}
+		return 0 // ignored
+	})
+
+	return me
+}
+
+// Instantiates a new [HotKey] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+//
+// # Example
+//
+//	const ID_HK uint16 = 0x100
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	hk := ui.NewHotKeyDlg(
+//		wndOwner, ID_HK, ui.LAY_NONE_NONE)
+func NewHotKeyDlg(parent Parent, ctrlId uint16, layout LAY) *HotKey {
+	win.InitCommonControlsEx(co.ICC_HOTKEY_CLASS) // before the dialog is created
+
+	me := &HotKey{
+		_BaseCtrl: newBaseCtrl(ctrlId),
+		events:    EventsHotKey{ctrlId, &parent.base().userEvents},
+	}
+
+	parent.base().beforeUserEvents.WmInitDialog(func(_ WmInitDialog) bool {
+		me.assignDialog(parent)
+		parent.base().layout.Add(parent, me.hWnd, layout)
+		return true // ignored
+	})
+
+	return me
+}
+
+// Exposes all the control notifications the can be handled.
+//
+// Panics if called after the control has been created.
+func (me *HotKey) On() *EventsHotKey {
+	me.panicIfAddingEventAfterCreated()
+	return &me.events
+}
+
+// Retrieves the virtual key code and the modifiers with [HKM_GETHOTKEY]. If no
+// key was entered, the virtual key code is zero.
+//
+// [HKM_GETHOTKEY]: https://learn.microsoft.com/en-us/windows/win32/controls/hkm-gethotkey
+func (me *HotKey) HotKey() (co.VK, co.HOTKEYF) {
+	ret, _ := me.hWnd.SendMessage(co.HKM_GETHOTKEY, 0, 0)
+	vk, mods := utl.Break16(uint16(ret))
+	return co.VK(vk), co.HOTKEYF(mods)
+}
+
+// Sets the virtual key code and the modifiers with [HKM_SETHOTKEY].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [HKM_SETHOTKEY]: https://learn.microsoft.com/en-us/windows/win32/controls/hkm-sethotkey
+func (me *HotKey) SetHotKey(vk co.VK, modifiers co.HOTKEYF) *HotKey {
+	me.hWnd.SendMessage(co.HKM_SETHOTKEY,
+		win.WPARAM(utl.Make16(uint8(vk), uint8(modifiers))), 0)
+	return me
+}
+
+// Defines the invalid key combinations, and the modifiers to be used when the
+// user enters one of them, with [HKM_SETRULES].
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var hk *ui.HotKey // initialized somewhere
+//
+//	hk.SetRules(co.HKCOMB_NONE|co.HKCOMB_S, co.HOTKEYF_CONTROL)
+//
+// [HKM_SETRULES]: https://learn.microsoft.com/en-us/windows/win32/controls/hkm-setrules
+func (me *HotKey) SetRules(invalid co.HKCOMB, defModifiers co.HOTKEYF) *HotKey {
+	me.hWnd.SendMessage(co.HKM_SETRULES,
+		win.WPARAM(invalid), win.LPARAM(defModifiers))
+	return me
+}
+
+// Options for [NewHotKey]; returned by [OptsHotKey].
+type VarOptsHotKey struct {
+	ctrlId     uint16
+	layout     LAY
+	vk         co.VK
+	modifiers  co.HOTKEYF
+	position   win.POINT
+	size       win.SIZE
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+}
+
+// Options for [NewHotKey].
+func OptsHotKey() *VarOptsHotKey {
+	return &VarOptsHotKey{
+		size:       win.SIZE{Cx: int32(DpiX(120)), Cy: int32(DpiY(23))},
+		wndStyle:   co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP,
+		wndExStyle: co.WS_EX_LEFT,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsHotKey) CtrlId(id uint16) *VarOptsHotKey { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsHotKey) Layout(l LAY) *VarOptsHotKey { o.layout = l; return o }
+
+// Initial virtual key code and modifiers.
+//
+// Defaults to none.
+func (o *VarOptsHotKey) HotKey(vk co.VK, modifiers co.HOTKEYF) *VarOptsHotKey {
+	o.vk = vk
+	o.modifiers = modifiers
+	return o
+}
+
+// Position coordinates within parent window client area, in pixels, passed to
+// [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsHotKey) Position(x, y int) *VarOptsHotKey {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control width in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.DpiX(120).
+func (o *VarOptsHotKey) Width(w int) *VarOptsHotKey { o.size.Cx = int32(w); return o }
+
+// Control height in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.DpiY(23).
+func (o *VarOptsHotKey) Height(h int) *VarOptsHotKey { o.size.Cy = int32(h); return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP.
+func (o *VarOptsHotKey) WndStyle(s co.WS) *VarOptsHotKey { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT.
+func (o *VarOptsHotKey) WndExStyle(s co.WS_EX) *VarOptsHotKey { o.wndExStyle = s; return o }
+
+// Native [hot key] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [hot key]: https://learn.microsoft.com/en-us/windows/win32/controls/hot-key-controls
+type EventsHotKey struct {
+	ctrlId       uint16
+	parentEvents *EventsWindow
+}
+
+// [EN_CHANGE] message handler, sent when the user changes the key combination.
+//
+// [EN_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-change
+func (me *EventsHotKey) EnChange(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_CHANGE, fun)
+}
@@ -0,0 +1,272 @@
+//go:build windows
+
+package ui
+
+import (
+	"net"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [IP address] control, which handles IPv4 addresses.
+//
+// [IP address]: https://learn.microsoft.com/en-us/windows/win32/controls/ip-address-controls
+type IpAddress struct {
+	_BaseCtrl
+	events EventsIpAddress
+}
+
+// Creates a new [IpAddress] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	ip := ui.NewIpAddress(
+//		wndOwner,
+//		ui.OptsIpAddress().
+//			Position(ui.Dpi(20, 10)).
+//			Address(net.IPv4(192, 168, 0, 1)),
+//	)
+func NewIpAddress(parent Parent, opts *VarOptsIpAddress) *IpAddress {
+	win.InitCommonControlsEx(co.ICC_INTERNET_CLASSES)
+
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &IpAddress{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		events:    EventsIpAddress{opts.ctrlId, &parent.base().userEvents},
+	}
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		me.createWindow(opts.wndExStyle, "SysIPAddress32", "",
+			opts.wndStyle, opts.position, opts.size, parent, true)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		if opts.address != nil {
+			me.SetAddress(opts.address)
+		}
+		return 0 // ignored
+	})
+
+	return me
+}
+
+// Instantiates a new [IpAddress] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+//
+// # Example
+//
+//	const ID_IP uint16 = 0x100
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	ip := ui.NewIpAddressDlg(
+//		wndOwner, ID_IP, ui.LAY_NONE_NONE)
+func NewIpAddressDlg(parent Parent, ctrlId uint16, layout LAY) *IpAddress {
+	win.InitCommonControlsEx(co.ICC_INTERNET_CLASSES) // before the dialog is created
+
+	me := &IpAddress{
+		_BaseCtrl: newBaseCtrl(ctrlId),
+		events:    EventsIpAddress{ctrlId, &parent.base().userEvents},
+	}
+
+	parent.base().beforeUserEvents.WmInitDialog(func(_ WmInitDialog) bool {
+		me.assignDialog(parent)
+		parent.base().layout.Add(parent, me.hWnd, layout)
+		return true // ignored
+	})
+
+	return me
+}
+
+// Exposes all the control notifications the can be handled.
+//
+// Panics if called after the control has been created.
+func (me *IpAddress) On() *EventsIpAddress {
+	me.panicIfAddingEventAfterCreated()
+	return &me.events
+}
+
+// Retrieves the IPv4 address with [IPM_GETADDRESS]. Blank fields are returned
+// as zero.
+//
+// [IPM_GETADDRESS]: https://learn.microsoft.com/en-us/windows/win32/controls/ipm-getaddress
+func (me *IpAddress) Address() net.IP {
+	var packed uint32
+	me.hWnd.SendMessage(co.IPM_GETADDRESS, 0, win.LPARAM(unsafe.Pointer(&packed)))
+	return net.IPv4(byte(packed>>24), byte(packed>>16), byte(packed>>8), byte(packed))
+}
+
+// Clears the contents of the control with [IPM_CLEARADDRESS].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [IPM_CLEARADDRESS]: https://learn.microsoft.com/en-us/windows/win32/controls/ipm-clearaddress
+func (me *IpAddress) Clear() *IpAddress {
+	me.hWnd.SendMessage(co.IPM_CLEARADDRESS, 0, 0)
+	return me
+}
+
+// Sets the keyboard focus to the given zero-based field, from 0 to 3, with
+// [IPM_SETFOCUS].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [IPM_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/ipm-setfocus
+func (me *IpAddress) FocusField(field int) *IpAddress {
+	me.hWnd.SendMessage(co.IPM_SETFOCUS, win.WPARAM(field), 0)
+	return me
+}
+
+// Returns true if all fields are blank, with [IPM_ISBLANK].
+//
+// [IPM_ISBLANK]: https://learn.microsoft.com/en-us/windows/win32/controls/ipm-isblank
+func (me *IpAddress) IsBlank() bool {
+	ret, _ := me.hWnd.SendMessage(co.IPM_ISBLANK, 0, 0)
+	return ret != 0
+}
+
+// Sets the IPv4 address with [IPM_SETADDRESS].
+//
+// Panics if the address is not an IPv4 address.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [IPM_SETADDRESS]: https://learn.microsoft.com/en-us/windows/win32/controls/ipm-setaddress
+func (me *IpAddress) SetAddress(ip net.IP) *IpAddress {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		panic("IpAddress accepts only IPv4 addresses.")
+	}
+
+	packed := uint32(ip4[0])<<24 | uint32(ip4[1])<<16 | uint32(ip4[2])<<8 | uint32(ip4[3])
+	me.hWnd.SendMessage(co.IPM_SETADDRESS, 0, win.LPARAM(packed))
+	return me
+}
+
+// Sets the valid range of the given zero-based field, from 0 to 3, with
+// [IPM_SETRANGE].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [IPM_SETRANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/ipm-setrange
+func (me *IpAddress) SetRange(field int, min, max uint8) *IpAddress {
+	ret, _ := me.hWnd.SendMessage(co.IPM_SETRANGE,
+		win.WPARAM(field), win.LPARAM(utl.Make16(min, max)))
+	if ret == 0 {
+		panic("IPM_SETRANGE failed.")
+	}
+	return me
+}
+
+// Options for [NewIpAddress]; returned by [OptsIpAddress].
+type VarOptsIpAddress struct {
+	ctrlId     uint16
+	layout     LAY
+	address    net.IP
+	position   win.POINT
+	size       win.SIZE
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+}
+
+// Options for [NewIpAddress].
+func OptsIpAddress() *VarOptsIpAddress {
+	return &VarOptsIpAddress{
+		size:       win.SIZE{Cx: int32(DpiX(120)), Cy: int32(DpiY(23))},
+		wndStyle:   co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP,
+		wndExStyle: co.WS_EX_LEFT,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsIpAddress) CtrlId(id uint16) *VarOptsIpAddress { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsIpAddress) Layout(l LAY) *VarOptsIpAddress { o.layout = l; return o }
+
+// Initial IPv4 address.
+//
+// Defaults to blank.
+func (o *VarOptsIpAddress) Address(ip net.IP) *VarOptsIpAddress { o.address = ip; return o }
+
+// Position coordinates within parent window client area, in pixels, passed to
+// [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsIpAddress) Position(x, y int) *VarOptsIpAddress {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control width in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.DpiX(120).
+func (o *VarOptsIpAddress) Width(w int) *VarOptsIpAddress { o.size.Cx = int32(w); return o }
+
+// Control height in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.DpiY(23).
+func (o *VarOptsIpAddress) Height(h int) *VarOptsIpAddress { o.size.Cy = int32(h); return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP.
+func (o *VarOptsIpAddress) WndStyle(s co.WS) *VarOptsIpAddress { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT.
+func (o *VarOptsIpAddress) WndExStyle(s co.WS_EX) *VarOptsIpAddress { o.wndExStyle = s; return o }
+
+// Native [IP address] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [IP address]: https://learn.microsoft.com/en-us/windows/win32/controls/ip-address-controls
+type EventsIpAddress struct {
+	ctrlId       uint16
+	parentEvents *EventsWindow
+}
+
+// [EN_CHANGE] message handler.
+//
+// [EN_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-change
+func (me *EventsIpAddress) EnChange(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_CHANGE, fun)
+}
+
+// [EN_KILLFOCUS] message handler.
+//
+// [EN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-killfocus
+func (me *EventsIpAddress) EnKillFocus(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_KILLFOCUS, fun)
+}
+
+// [EN_SETFOCUS] message handler.
+//
+// [EN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-setfocus
+func (me *EventsIpAddress) EnSetFocus(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_SETFOCUS, fun)
+}
+
+// [IPN_FIELDCHANGED] message handler.
+//
+// The handler can change the IValue field to modify the field value.
+//
+// [IPN_FIELDCHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/ipn-fieldchanged
+func (me *EventsIpAddress) IpnFieldChanged(fun func(p *win.NMIPADDRESS)) {
+	me.parentEvents.WmNotify(me.ctrlId, co.IPN_FIELDCHANGED, func(p unsafe.Pointer) uintptr {
+		fun((*win.NMIPADDRESS)(p))
+		return me.parentEvents.defProcVal
+	})
+}
@@ -0,0 +1,256 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [list box] control.
+//
+// [list box]: https://learn.microsoft.com/en-us/windows/win32/controls/about-list-boxes
+type ListBox struct {
+	_BaseCtrl
+	events      EventsListBox
+	itemsData   map[uintptr]interface{} // data associated with each item; keys are stored with LB_SETITEMDATA
+	nextDataKey uintptr
+	Items       CollectionListBoxItems // Methods to interact with the items collection.
+}
+
+// Creates a new [ListBox] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	lst := ui.NewListBox(
+//		wndOwner,
+//		ui.OptsListBox().
+//			Position(ui.Dpi(20, 10)).
+//			Texts("Avocado", "Banana", "Pineapple"),
+//	)
+func NewListBox(parent Parent, opts *VarOptsListBox) *ListBox {
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &ListBox{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		events:    EventsListBox{opts.ctrlId, &parent.base().userEvents},
+		itemsData: make(map[uintptr]interface{}),
+	}
+	me.Items.owner = me
+
+	parent.base().beforeUserEvents.WmCreate(func(_ WmCreate) int {
+		me.createWindow(opts.wndExStyle, "LISTBOX", "",
+			opts.wndStyle|co.WS(opts.ctrlStyle), opts.position, opts.size, parent, true)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		me.Items.Add(opts.texts...)
+		if opts.selected != -1 {
+			me.Items.Select(opts.selected)
+		}
+		return 0 // ignored
+	})
+
+	me.defaultMessageHandlers(parent)
+	return me
+}
+
+// Instantiates a new [ListBox] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+//
+// # Example
+//
+//	const ID_LST uint16 = 0x100
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	lst := ui.NewListBoxDlg(
+//		wndOwner, ID_LST, ui.LAY_NONE_NONE)
+func NewListBoxDlg(parent Parent, ctrlId uint16, layout LAY) *ListBox {
+	me := &ListBox{
+		_BaseCtrl: newBaseCtrl(ctrlId),
+		events:    EventsListBox{ctrlId, &parent.base().userEvents},
+		itemsData: make(map[uintptr]interface{}),
+	}
+	me.Items.owner = me
+
+	parent.base().beforeUserEvents.WmInitDialog(func(_ WmInitDialog) bool {
+		me.assignDialog(parent)
+		parent.base().layout.Add(parent, me.hWnd, layout)
+		return true // ignored
+	})
+
+	me.defaultMessageHandlers(parent)
+	return me
+}
+
+func (me *ListBox) defaultMessageHandlers(parent Parent) {
+	parent.base().afterUserEvents.WmDestroy(func() {
+		me.itemsData = make(map[uintptr]interface{}) // release user data
+	})
+}
+
+// Exposes all the control notifications the can be handled.
+//
+// Panics if called after the control has been created.
+func (me *ListBox) On() *EventsListBox {
+	me.panicIfAddingEventAfterCreated()
+	return &me.events
+}
+
+// Returns true if the control has the co.LBS_MULTIPLESEL or
+// co.LBS_EXTENDEDSEL styles.
+func (me *ListBox) IsMultiSel() bool {
+	stylesRet, _ := me.hWnd.GetWindowLongPtr(co.GWLP_STYLE)
+	styles := co.LBS(stylesRet)
+	return (styles&co.LBS_MULTIPLESEL) != 0 || (styles&co.LBS_EXTENDEDSEL) != 0
+}
+
+// Enables or disables redrawing with [WM_SETREDRAW].
+//
+// Use this method to disable redrawing while you're updating multiple items at
+// once.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [WM_SETREDRAW]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-setredraw
+func (me *ListBox) SetRedraw(allowRedraw bool) *ListBox {
+	me.hWnd.SendMessage(co.WM_SETREDRAW,
+		win.WPARAM(utl.BoolToUintptr(allowRedraw)), 0)
+	return me
+}
+
+// Options for [NewListBox]; returned by [OptsListBox].
+type VarOptsListBox struct {
+	ctrlId     uint16
+	layout     LAY
+	position   win.POINT
+	size       win.SIZE
+	ctrlStyle  co.LBS
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+
+	texts    []string
+	selected int
+}
+
+// Options for [NewListBox].
+func OptsListBox() *VarOptsListBox {
+	return &VarOptsListBox{
+		size:       win.SIZE{Cx: int32(DpiX(120)), Cy: int32(DpiY(120))},
+		ctrlStyle:  co.LBS_NOTIFY | co.LBS_NOINTEGRALHEIGHT,
+		wndStyle:   co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL,
+		wndExStyle: co.WS_EX_LEFT | co.WS_EX_CLIENTEDGE,
+		selected:   -1,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsListBox) CtrlId(id uint16) *VarOptsListBox { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsListBox) Layout(l LAY) *VarOptsListBox { o.layout = l; return o }
+
+// Position coordinates within parent window client area, in pixels, passed to
+// [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsListBox) Position(x, y int) *VarOptsListBox {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control size in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(120, 120).
+func (o *VarOptsListBox) Size(cx int, cy int) *VarOptsListBox {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// List box control [style], passed to [win.CreateWindowEx].
+//
+// Defaults to co.LBS_NOTIFY | co.LBS_NOINTEGRALHEIGHT.
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/list-box-styles
+func (o *VarOptsListBox) CtrlStyle(s co.LBS) *VarOptsListBox { o.ctrlStyle = s; return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL.
+func (o *VarOptsListBox) WndStyle(s co.WS) *VarOptsListBox { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT | co.WS_EX_CLIENTEDGE.
+func (o *VarOptsListBox) WndExStyle(s co.WS_EX) *VarOptsListBox { o.wndExStyle = s; return o }
+
+// Texts to be added to the ListBox.
+//
+// Defaults to none.
+func (o *VarOptsListBox) Texts(t ...string) *VarOptsListBox { o.texts = t; return o }
+
+// Zero-based index of the item initially selected.
+//
+// Defaults to -1 (none).
+func (o *VarOptsListBox) Selected(i int) *VarOptsListBox { o.selected = i; return o }
+
+// Native [list box] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [list box]: https://learn.microsoft.com/en-us/windows/win32/controls/about-list-boxes
+type EventsListBox struct {
+	ctrlId       uint16
+	parentEvents *EventsWindow
+}
+
+// [LBN_DBLCLK] message handler.
+//
+// [LBN_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-dblclk
+func (me *EventsListBox) LbnDblClk(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.LBN_DBLCLK, fun)
+}
+
+// [LBN_ERRSPACE] message handler.
+//
+// [LBN_ERRSPACE]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-errspace
+func (me *EventsListBox) LbnErrSpace(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.LBN_ERRSPACE, fun)
+}
+
+// [LBN_KILLFOCUS] message handler.
+//
+// [LBN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-killfocus
+func (me *EventsListBox) LbnKillFocus(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.LBN_KILLFOCUS, fun)
+}
+
+// [LBN_SELCANCEL] message handler.
+//
+// [LBN_SELCANCEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-selcancel
+func (me *EventsListBox) LbnSelCancel(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.LBN_SELCANCEL, fun)
+}
+
+// [LBN_SELCHANGE] message handler.
+//
+// [LBN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-selchange
+func (me *EventsListBox) LbnSelChange(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.LBN_SELCHANGE, fun)
+}
+
+// [LBN_SETFOCUS] message handler.
+//
+// [LBN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-setfocus
+func (me *EventsListBox) LbnSetFocus(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.LBN_SETFOCUS, fun)
+}
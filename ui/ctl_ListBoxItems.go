@@ -0,0 +1,321 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// The items collection.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning [ListBox].
+type CollectionListBoxItems struct {
+	owner *ListBox
+}
+
+// Adds one or more items using [LB_ADDSTRING].
+//
+// [LB_ADDSTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-addstring
+func (me *CollectionListBoxItems) Add(texts ...string) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	for _, text := range texts {
+		pText := wbuf.PtrAllowEmpty(text)
+		me.owner.hWnd.SendMessage(co.LB_ADDSTRING,
+			0, win.LPARAM(pText))
+		wbuf.Clear()
+	}
+}
+
+// Returns all items with [LB_GETTEXT].
+//
+// [LB_GETTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-gettext
+func (me *CollectionListBoxItems) All() []string {
+	nItems := me.Count()
+	items := make([]string, 0, nItems)
+	for i := uint(0); i < nItems; i++ {
+		items = append(items, me.Get(int(i)))
+	}
+	return items
+}
+
+// Retrieves the number of items with [LB_GETCOUNT].
+//
+// [LB_GETCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getcount
+func (me *CollectionListBoxItems) Count() uint {
+	n, _ := me.owner.hWnd.SendMessage(co.LB_GETCOUNT, 0, 0)
+	return uint(n)
+}
+
+// Returns the user-custom data stored for the item at the given index, or nil
+// if none.
+//
+// # Example
+//
+//	type Person struct {
+//		Name string
+//	}
+//
+//	var lst *ui.ListBox // initialized somewhere
+//
+//	lst.Items.SetData(0, &Person{Name: "foo"})
+//
+//	if person, ok := lst.Items.Data(0).(*Person); ok {
+//		println(person.Name)
+//	}
+func (me *CollectionListBoxItems) Data(index int) interface{} {
+	if key := me.dataKey(index); key != 0 {
+		if data, ok := me.owner.itemsData[key]; ok {
+			return data
+		}
+	}
+	return nil
+}
+
+// Deletes the item at the given index with [LB_DELETESTRING].
+//
+// Panics on error.
+//
+// [LB_DELETESTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-deletestring
+func (me *CollectionListBoxItems) Delete(index int) {
+	key := me.dataKey(index)
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_DELETESTRING, win.WPARAM(index), 0)
+	if int32(ret) == -1 { // LB_ERR
+		panic(fmt.Sprintf("LB_DELETESTRING %d failed.", index))
+	}
+	delete(me.owner.itemsData, key)
+}
+
+// Deletes all items with [LB_RESETCONTENT].
+//
+// [LB_RESETCONTENT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-resetcontent
+func (me *CollectionListBoxItems) DeleteAll() {
+	me.owner.hWnd.SendMessage(co.LB_RESETCONTENT, 0, 0)
+	me.owner.itemsData = make(map[uintptr]interface{})
+}
+
+// Deletes all selected items with [LB_DELETESTRING].
+//
+// Panics on error.
+//
+// [LB_DELETESTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-deletestring
+func (me *CollectionListBoxItems) DeleteSelected() {
+	indexes := me.SelectedMulti()
+	for i := len(indexes) - 1; i >= 0; i-- { // from last to first, so indexes remain valid
+		me.Delete(indexes[i])
+	}
+}
+
+// Searches for the first item with the given exact text, case-insensitive,
+// with [LB_FINDSTRINGEXACT].
+//
+// [LB_FINDSTRINGEXACT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-findstringexact
+func (me *CollectionListBoxItems) Find(text string) (int, bool) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	idxBaseSearch := -1 // search the whole list
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_FINDSTRINGEXACT,
+		win.WPARAM(idxBaseSearch), win.LPARAM(wbuf.PtrAllowEmpty(text)))
+	if idx := int(int32(ret)); idx != -1 { // LB_ERR
+		return idx, true
+	}
+	return -1, false // not found
+}
+
+// Returns the text of the item at the given index with [LB_GETTEXT].
+//
+// Panics if the index is not valid.
+//
+// [LB_GETTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-gettext
+func (me *CollectionListBoxItems) Get(index int) string {
+	nChars, _ := me.owner.hWnd.SendMessage(co.LB_GETTEXTLEN, win.WPARAM(index), 0)
+	if int32(nChars) == -1 { // LB_ERR
+		panic(fmt.Sprintf("Invalid ListBox index: %d", index))
+	}
+
+	recvBuf := wstr.NewBufDecoder(uint(nChars) + 1)
+	defer recvBuf.Free()
+
+	me.owner.hWnd.SendMessage(co.LB_GETTEXT,
+		win.WPARAM(index), win.LPARAM(recvBuf.UnsafePtr()))
+	return recvBuf.String()
+}
+
+// Inserts an item at the given position with [LB_INSERTSTRING]. If index is
+// -1, the item is added at the end of the list.
+//
+// Unlike [CollectionListBoxItems.Add], the list is not sorted, even if it has
+// the co.LBS_SORT style.
+//
+// Panics on error.
+//
+// [LB_INSERTSTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-insertstring
+func (me *CollectionListBoxItems) Insert(index int, text string) int {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_INSERTSTRING,
+		win.WPARAM(index), win.LPARAM(wbuf.PtrAllowEmpty(text)))
+	newIdx := int(int32(ret))
+	if newIdx < 0 { // LB_ERR or LB_ERRSPACE
+		panic(fmt.Sprintf("LB_INSERTSTRING %d, \"%s\" failed.", index, text))
+	}
+	return newIdx
+}
+
+// Tells whether the item at the given index is selected, with [LB_GETSEL].
+//
+// [LB_GETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getsel
+func (me *CollectionListBoxItems) IsSelected(index int) bool {
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_GETSEL, win.WPARAM(index), 0)
+	return int32(ret) > 0
+}
+
+// Returns the last item with [LB_GETTEXT].
+//
+// Panics if empty.
+//
+// [LB_GETTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-gettext
+func (me *CollectionListBoxItems) Last() string {
+	return me.Get(int(me.Count()) - 1)
+}
+
+// Selects the given item with [LB_SETCURSEL], in a single-selection list box.
+// For multi-selection list boxes, use [CollectionListBoxItems.SelectMulti].
+//
+// If index is -1, selection is cleared.
+//
+// [LB_SETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setcursel
+func (me *CollectionListBoxItems) Select(index int) {
+	me.owner.hWnd.SendMessage(co.LB_SETCURSEL, win.WPARAM(index), 0)
+}
+
+// Selects or deselects the given items with [LB_SETSEL], in a multi-selection
+// list box. If no indexes are passed, all items are affected.
+//
+// [LB_SETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setsel
+func (me *CollectionListBoxItems) SelectMulti(doSelect bool, indexes ...int) {
+	if len(indexes) == 0 {
+		allItems := -1
+		me.owner.hWnd.SendMessage(co.LB_SETSEL,
+			win.WPARAM(utl.BoolToUintptr(doSelect)), win.LPARAM(allItems))
+		return
+	}
+	for _, index := range indexes {
+		me.owner.hWnd.SendMessage(co.LB_SETSEL,
+			win.WPARAM(utl.BoolToUintptr(doSelect)), win.LPARAM(index))
+	}
+}
+
+// Retrieves the selected index with [LB_GETCURSEL], in a single-selection list
+// box. For multi-selection list boxes, use
+// [CollectionListBoxItems.SelectedMulti].
+//
+// If no item is selected, returns -1.
+//
+// [LB_GETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getcursel
+func (me *CollectionListBoxItems) Selected() int {
+	n, _ := me.owner.hWnd.SendMessage(co.LB_GETCURSEL, 0, 0)
+	return int(int32(n))
+}
+
+// Retrieves the number of selected items with [LB_GETSELCOUNT].
+//
+// For a single-selection list box, returns 1 if an item is selected, or 0
+// otherwise.
+//
+// [LB_GETSELCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getselcount
+func (me *CollectionListBoxItems) SelectedCount() uint {
+	if !me.owner.IsMultiSel() {
+		if me.Selected() == -1 {
+			return 0
+		}
+		return 1
+	}
+
+	n, _ := me.owner.hWnd.SendMessage(co.LB_GETSELCOUNT, 0, 0)
+	return uint(n)
+}
+
+// Retrieves the indexes of all selected items with [LB_GETSELITEMS].
+//
+// Works with single-selection list boxes too, returning zero or one index.
+//
+// [LB_GETSELITEMS]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getselitems
+func (me *CollectionListBoxItems) SelectedMulti() []int {
+	if !me.owner.IsMultiSel() {
+		if idx := me.Selected(); idx != -1 {
+			return []int{idx}
+		}
+		return []int{}
+	}
+
+	nSel := me.SelectedCount()
+	if nSel == 0 {
+		return []int{}
+	}
+
+	buf := make([]int32, nSel)
+	me.owner.hWnd.SendMessage(co.LB_GETSELITEMS,
+		win.WPARAM(nSel), win.LPARAM(unsafe.Pointer(&buf[0])))
+
+	indexes := make([]int, 0, nSel)
+	for _, idx := range buf {
+		indexes = append(indexes, int(idx))
+	}
+	return indexes
+}
+
+// Stores user-custom data for the item at the given index. Internally, a key
+// is stored with [LB_SETITEMDATA], so the data stays with the item even if
+// other items are added or removed.
+//
+// Panics on error.
+//
+// # Example
+//
+//	type Person struct {
+//		Name string
+//	}
+//
+//	var lst *ui.ListBox // initialized somewhere
+//
+//	lst.Items.SetData(0, &Person{Name: "foo"})
+//
+//	if person, ok := lst.Items.Data(0).(*Person); ok {
+//		println(person.Name)
+//	}
+//
+// [LB_SETITEMDATA]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setitemdata
+func (me *CollectionListBoxItems) SetData(index int, data interface{}) {
+	key := me.dataKey(index)
+	if key == 0 { // item has no data yet
+		me.owner.nextDataKey++
+		key = me.owner.nextDataKey
+
+		ret, _ := me.owner.hWnd.SendMessage(co.LB_SETITEMDATA,
+			win.WPARAM(index), win.LPARAM(key))
+		if int32(ret) == -1 { // LB_ERR
+			panic(fmt.Sprintf("LB_SETITEMDATA %d failed.", index))
+		}
+	}
+	me.owner.itemsData[key] = data
+}
+
+// Returns the key stored with LB_SETITEMDATA, or zero if none.
+func (me *CollectionListBoxItems) dataKey(index int) uintptr {
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_GETITEMDATA, win.WPARAM(index), 0)
+	if int32(ret) == -1 { // LB_ERR
+		return 0
+	}
+	return ret
+}
@@ -4,11 +4,14 @@ package ui
 
 import (
 	"fmt"
+	"strings"
+	"syscall"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/internal/utl"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
 )
 
 // Native [list view] control.
@@ -16,12 +19,23 @@ import (
 // [list view]: https://learn.microsoft.com/en-us/windows/win32/controls/list-view-controls-overview
 type ListView struct {
 	_BaseCtrl
-	events       EventsListView
-	hContextMenu win.HMENU
-	itemsData    map[int]interface{} // data associated with each item; replaces LPARAM approach
-	header       *Header
-	Cols         CollectionListViewCols  // Methods to interact with the columns collection.
-	Items        CollectionListViewItems // Methods to interact with the items collection.
+	events         EventsListView
+	hContextMenu   win.HMENU
+	itemsData      map[int]interface{} // data associated with each item; replaces LPARAM approach
+	virtualSrc     func(index int) ListViewVirtualItem
+	virtualFind    bool // LVN_ODFINDITEM handler installed by SetVirtualSource
+	customDraw     func(item ListViewItem, columnIndex int, state co.CDIS, draw *ListViewDraw)
+	customDrawOn   bool            // NM_CUSTOMDRAW handler installed by SetCustomDraw
+	customDrawDefs [2]win.COLORREF // default text and background colors of the item being drawn
+	itemDrag       func(sources []ListViewItem, target ListViewItem) bool
+	dragEvents     *EventsWindow // parent events, where SetItemDrag installs the drag handlers
+	dragOn         bool          // drag handlers installed by SetItemDrag
+	drag           _ItemDrag
+	dragSrcs       []int32 // indexes of the items being dragged, ascending
+	dragTarget     int32   // -1 if the cursor is not over a valid target
+	header         *Header
+	Cols           CollectionListViewCols  // Methods to interact with the columns collection.
+	Items          CollectionListViewItems // Methods to interact with the items collection.
 }
 
 // Creates a new [ListView] with [win.CreateWindowEx].
@@ -32,6 +46,7 @@ func NewListView(parent Parent, opts *VarOptsListView) *ListView {
 		events:       EventsListView{opts.ctrlId, &parent.base().userEvents},
 		hContextMenu: opts.contextMenu,
 		itemsData:    make(map[int]interface{}),
+		dragTarget:   -1,
 		header:       newHeaderFromListView(parent),
 	}
 	me.Cols.owner = me
@@ -71,6 +86,7 @@ func NewListViewDlg(parent Parent, ctrlId uint16, contextMenuId uint16, layout L
 		events:       EventsListView{ctrlId, &parent.base().userEvents},
 		hContextMenu: hMenu,
 		itemsData:    make(map[int]interface{}),
+		dragTarget:   -1,
 		header:       newHeaderFromListView(parent),
 	}
 	me.Cols.owner = me
@@ -88,6 +104,8 @@ func NewListViewDlg(parent Parent, ctrlId uint16, contextMenuId uint16, layout L
 }
 
 func (me *ListView) defaultMessageHandlers(parent Parent) {
+	me.dragEvents = &parent.base().beforeUserEvents
+
 	me.subclassEvents.WmGetDlgCode(func(p WmGetDlgCode) co.DLGC {
 		if !p.IsQuery() && p.VirtualKeyCode() == co.VK_RETURN { // Enter key
 			iCode := int32(co.LVN_KEYDOWN)
@@ -112,7 +130,9 @@ func (me *ListView) defaultMessageHandlers(parent Parent) {
 		hasCtrl := (win.GetAsyncKeyState(co.VK_CONTROL) & 0x8000) != 0
 		hasShift := (win.GetAsyncKeyState(co.VK_SHIFT) & 0x8000) != 0
 
-		if hasCtrl && nmk.WVKey == 'A' { // Ctrl+A pressed?
+		if me.drag.active && nmk.WVKey == co.VK_ESCAPE {
+			me.endItemDrag()
+		} else if hasCtrl && nmk.WVKey == 'A' { // Ctrl+A pressed?
 			me.Items.SelectAll(true)
 		} else if nmk.WVKey == co.VK_APPS { // context menu key
 			me.showContextMenu(false, hasCtrl, hasShift)
@@ -129,6 +149,13 @@ func (me *ListView) defaultMessageHandlers(parent Parent) {
 		return 0 // ignored
 	})
 
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.LVN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
+		if me.virtualSrc != nil {
+			me.fillVirtualItem(&(*win.NMLVDISPINFO)(p).Item)
+		}
+		return 0 // ignored
+	})
+
 	parent.base().afterUserEvents.WmNotify(me.ctrlId, co.LVN_DELETEITEM, func(p unsafe.Pointer) uintptr {
 		nmlv := (*win.NMLISTVIEW)(p)
 		item := me.Items.Get(int(nmlv.IItem))
@@ -136,6 +163,14 @@ func (me *ListView) defaultMessageHandlers(parent Parent) {
 		return 0 // ignored
 	})
 
+	parent.base().onDpiChanged(func(oldDpi, newDpi int) {
+		kinds := []co.LVSIL{co.LVSIL_NORMAL, co.LVSIL_SMALL, co.LVSIL_STATE}
+		for _, kind := range kinds {
+			rescaleCtrlImageList(me.hWnd, co.LVM_GETIMAGELIST, co.LVM_SETIMAGELIST,
+				win.WPARAM(kind), oldDpi, newDpi)
+		}
+	})
+
 	parent.base().afterUserEvents.WmDestroy(func() {
 		if me.hContextMenu != 0 {
 			me.hContextMenu.DestroyMenu()
@@ -179,6 +214,361 @@ func (me *ListView) showContextMenu(followCursor, hasCtrl, hasShift bool) {
 	hSubMenu0.ShowAtPoint(menuPos, hParent, me.hWnd)
 }
 
+// Fills the LVITEM requested in LVN_GETDISPINFO with the data returned by the
+// virtual source callback.
+func (me *ListView) fillVirtualItem(lvi *win.LVITEM) {
+	item := me.virtualSrc(int(lvi.IItem))
+
+	if (lvi.Mask & co.LVIF_TEXT) != 0 {
+		if buf := lvi.PszText(); len(buf) > 0 {
+			text := ""
+			if int(lvi.ISubItem) < len(item.Texts) {
+				text = item.Texts[lvi.ISubItem]
+			}
+			wstr.EncodeToBuf(text, buf) // truncated if too long
+		}
+	}
+	if (lvi.Mask & co.LVIF_IMAGE) != 0 {
+		lvi.IImage = int32(item.IconIndex)
+	}
+	if (lvi.Mask & co.LVIF_INDENT) != 0 {
+		lvi.IIndent = int32(item.Indent)
+	}
+	if (lvi.Mask & co.LVIF_STATE) != 0 {
+		lvi.State = (lvi.State &^ lvi.StateMask) | (item.State & lvi.StateMask)
+	}
+}
+
+// Default LVN_ODFINDITEM processing for owner-data list views: a linear,
+// case-insensitive search on the first column texts, using the virtual source
+// callback.
+func (me *ListView) findVirtualItem(nfi *win.NMLVFINDITEM) int {
+	flags := nfi.Lvfi.Flags
+	if me.virtualSrc == nil ||
+		(flags&(co.LVFI_STRING|co.LVFI_PARTIAL|co.LVFI_SUBSTRING)) == 0 {
+		return -1 // only searches by text are supported
+	}
+
+	needle := strings.ToLower(wstr.DecodePtr(nfi.Lvfi.Psz))
+	isPartial := (flags & (co.LVFI_PARTIAL | co.LVFI_SUBSTRING)) != 0
+
+	count := int(me.Items.Count())
+	start := int(nfi.IStart)
+	if start < 0 || start >= count {
+		start = 0
+	}
+
+	for n := 0; n < count; n++ {
+		idx := start + n
+		if idx >= count {
+			if (flags & co.LVFI_WRAP) == 0 {
+				break
+			}
+			idx -= count
+		}
+
+		texts := me.virtualSrc(idx).Texts
+		if len(texts) == 0 {
+			continue
+		}
+		text := strings.ToLower(texts[0])
+		if (isPartial && strings.HasPrefix(text, needle)) || text == needle {
+			return idx
+		}
+	}
+	return -1 // not found
+}
+
+// Runs the NM_CUSTOMDRAW stages, calling the custom draw callback for each item
+// or, in report view, for each column of each item.
+func (me *ListView) processCustomDraw(p *win.NMLVCUSTOMDRAW) co.CDRF {
+	switch p.Nmcd.DwDrawStage {
+	case co.CDDS_PREPAINT:
+		return co.CDRF_NOTIFYITEMDRAW
+	case co.CDDS_ITEMPREPAINT:
+		me.customDrawDefs = [2]win.COLORREF{p.ClrText, p.ClrTextBk} // defaults for all columns
+		if me.View() == co.LV_VIEW_DETAILS {
+			return co.CDRF_NOTIFYSUBITEMDRAW
+		}
+		return me.applyCustomDraw(p, -1) // other views have no columns
+	case co.CDDS_ITEMPREPAINT | co.CDDS_SUBITEM:
+		return me.applyCustomDraw(p, int(p.ISubItem))
+	default:
+		return co.CDRF_DODEFAULT
+	}
+}
+
+func (me *ListView) applyCustomDraw(p *win.NMLVCUSTOMDRAW, columnIndex int) co.CDRF {
+	item := me.Items.Get(int(p.Nmcd.DwItemSpec))
+	draw := ListViewDraw{
+		TextColor: me.customDrawDefs[0],
+		BkColor:   me.customDrawDefs[1],
+	}
+	if columnIndex == -1 {
+		me.customDraw(item, 0, p.Nmcd.UItemState, &draw)
+	} else {
+		me.customDraw(item, columnIndex, p.Nmcd.UItemState, &draw)
+	}
+
+	if draw.OwnerPaint != nil {
+		var rc win.RECT
+		switch columnIndex {
+		case -1:
+			rc = item.ItemRect(co.LVIR_BOUNDS)
+		case 0: // LVIR_BOUNDS would return the entire row
+			rcIcon := item.SubItemRect(0, co.LVIR_ICON)
+			rc = item.SubItemRect(0, co.LVIR_LABEL)
+			rc.Left = rcIcon.Left
+		default:
+			rc = item.SubItemRect(columnIndex, co.LVIR_BOUNDS)
+		}
+		draw.OwnerPaint(p.Nmcd.Hdc, rc)
+		return co.CDRF_SKIPDEFAULT
+	}
+
+	// Colors and font are always set, because the values of a column would be
+	// carried to the next ones.
+	p.ClrText = draw.TextColor
+	p.ClrTextBk = draw.BkColor
+	hFont := draw.Font
+	if hFont == win.HFONT(0) {
+		hFontRet, _ := me.hWnd.SendMessage(co.WM_GETFONT, 0, 0)
+		hFont = win.HFONT(hFontRet)
+	}
+	p.Nmcd.Hdc.SelectObjectFont(hFont)
+	return co.CDRF_NEWFONT
+}
+
+// Installs the handlers of the item dragging. Since the parent window doesn't
+// pass the mouse messages to DefWindowProc when they're handled, this is done
+// only when SetItemDrag is called.
+func (me *ListView) itemDragMessageHandlers() {
+	me.dragEvents.WmNotify(me.ctrlId, co.LVN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
+		if me.itemDrag != nil && !me.isSorted() {
+			me.beginItemDrag((*win.NMLISTVIEW)(p))
+		}
+		return 0 // ignored
+	})
+
+	// While dragging, the mouse is captured by the parent window.
+	me.dragEvents.WmMouseMove(func(_ WmMouse) {
+		if me.drag.active {
+			me.updateItemDrag()
+		}
+	})
+	me.dragEvents.WmLButtonUp(func(_ WmMouse) {
+		if me.drag.active {
+			me.dropItemDrag()
+		}
+	})
+	me.dragEvents.WmCaptureChanged(func(_ WmCaptureChanged) {
+		if me.drag.active { // capture taken by someone else
+			me.endItemDrag()
+		}
+	})
+	me.dragEvents.WmTimer(itemDragTimerId(me.ctrlId), func() {
+		if me.drag.active {
+			me.drag.scrollStep()
+			me.updateItemDrag()
+		}
+	})
+}
+
+// Starts dragging the selected items, on LVN_BEGINDRAG.
+func (me *ListView) beginItemDrag(nmlv *win.NMLISTVIEW) {
+	var ptImg win.POINT // upper-left corner of the image, in view coordinates
+	hImgRet, _ := me.hWnd.SendMessage(co.LVM_CREATEDRAGIMAGE,
+		win.WPARAM(nmlv.IItem), win.LPARAM(unsafe.Pointer(&ptImg)))
+	hImg := win.HIMAGELIST(hImgRet)
+
+	var hotspot win.POINT
+	if hImg != win.HIMAGELIST(0) {
+		var ptOrigin win.POINT // fails in report and list views, which have no origin
+		me.hWnd.SendMessage(co.LVM_GETORIGIN, 0, win.LPARAM(unsafe.Pointer(&ptOrigin)))
+		hotspot.X = nmlv.PtAction.X + ptOrigin.X - ptImg.X
+		hotspot.Y = nmlv.PtAction.Y + ptOrigin.Y - ptImg.Y
+	}
+
+	me.dragSrcs = me.dragSrcs[:0]
+	if me.Items.Get(int(nmlv.IItem)).IsSelected() {
+		for _, item := range me.Items.Selected() {
+			me.dragSrcs = append(me.dragSrcs, item.index)
+		}
+	} else {
+		me.dragSrcs = append(me.dragSrcs, nmlv.IItem)
+	}
+	me.dragTarget = -1
+
+	hParent, _ := me.hWnd.GetAncestor(co.GA_PARENT)
+	me.drag.begin(me.hWnd, hParent, hImg, hotspot, itemDragTimerId(me.ctrlId))
+	me.updateItemDrag()
+}
+
+// Highlights the item under the cursor, if the dragged items can be moved to
+// its position.
+func (me *ListView) updateItemDrag() {
+	pt := me.drag.cursorPos()
+	target, hasTarget := me.Items.HitTest(pt)
+	if !hasTarget && me.View() == co.LV_VIEW_DETAILS { // cursor beyond the last column?
+		target, hasTarget = me.Items.HitTest(win.POINT{X: 2, Y: pt.Y})
+	}
+	if !hasTarget && me.Items.Count() > 0 { // cursor below the last item?
+		last := me.Items.Last()
+		if rc := last.ItemRect(co.LVIR_BOUNDS); pt.Y >= rc.Bottom {
+			target, hasTarget = last, true
+		}
+	}
+
+	idxTarget := int32(-1)
+	if hasTarget {
+		idxTarget = target.index
+		for _, idxSrc := range me.dragSrcs {
+			if idxSrc == idxTarget { // can't drop onto a dragged item
+				idxTarget = -1
+				break
+			}
+		}
+	}
+
+	if idxTarget != me.dragTarget {
+		me.drag.showImage(false)
+		me.setDropHighlight(me.dragTarget, false)
+		me.setDropHighlight(idxTarget, true)
+		me.hWnd.UpdateWindow()
+		me.drag.showImage(true)
+		me.dragTarget = idxTarget
+	}
+
+	me.drag.moveImage(idxTarget != -1)
+	me.drag.updateAutoScroll(pt)
+}
+
+// Finishes the drag operation when the mouse button is released, moving the
+// items if the callback allows it.
+func (me *ListView) dropItemDrag() {
+	idxSrcs := append([]int32{}, me.dragSrcs...)
+	idxTarget := me.dragTarget
+	me.endItemDrag()
+	if idxTarget == -1 {
+		return
+	}
+
+	sources := make([]ListViewItem, 0, len(idxSrcs))
+	for _, idxSrc := range idxSrcs {
+		sources = append(sources, me.Items.Get(int(idxSrc)))
+	}
+	if !me.itemDrag(sources, me.Items.Get(int(idxTarget))) {
+		return
+	}
+
+	if me.virtualSrc != nil { // the callback has reordered the items itself
+		me.hWnd.InvalidateRect(nil, true)
+		return
+	}
+	me.moveItems(idxSrcs, idxTarget)
+}
+
+// Cancels or finishes the drag operation, removing the drop highlight.
+func (me *ListView) endItemDrag() {
+	me.drag.end()
+	me.setDropHighlight(me.dragTarget, false)
+	me.dragSrcs = me.dragSrcs[:0]
+	me.dragTarget = -1
+}
+
+// Adds or removes the drop highlight of the given item; -1 does nothing.
+func (me *ListView) setDropHighlight(index int32, doSet bool) {
+	if index == -1 {
+		return
+	}
+	lvi := win.LVITEM{
+		StateMask: co.LVIS_DROPHILITED,
+	}
+	if doSet {
+		lvi.State = co.LVIS_DROPHILITED
+	}
+	me.hWnd.SendMessage(co.LVM_SETITEMSTATE,
+		win.WPARAM(index), win.LPARAM(unsafe.Pointer(&lvi)))
+}
+
+// Moves the items, given by their ascending indexes, to the position of the
+// target item: after it, if moving down; before it, if moving up.
+//
+// The items are reordered with LVM_SORTITEMS, so they keep their unique IDs.
+// Since the indexes change during the sort, the final position of each item is
+// temporarily stored in its LPARAM, which is restored afterwards.
+func (me *ListView) moveItems(idxSrcs []int32, idxTarget int32) {
+	isSrc := make(map[int32]struct{}, len(idxSrcs))
+	for _, idxSrc := range idxSrcs {
+		isSrc[idxSrc] = struct{}{}
+	}
+
+	count := int32(me.Items.Count())
+	idxsRest := make([]int32, 0, count) // items which stay, in the current order
+	insertPos := 0
+	for idx := int32(0); idx < count; idx++ {
+		if _, ok := isSrc[idx]; ok {
+			continue
+		}
+		idxsRest = append(idxsRest, idx)
+		if idx == idxTarget {
+			insertPos = len(idxsRest) - 1
+			if idxTarget > idxSrcs[0] {
+				insertPos++ // moving down, so the items go after the target
+			}
+		}
+	}
+
+	idxsWanted := make([]int32, 0, count) // the final order
+	idxsWanted = append(idxsWanted, idxsRest[:insertPos]...)
+	idxsWanted = append(idxsWanted, idxSrcs...)
+	idxsWanted = append(idxsWanted, idxsRest[insertPos:]...)
+
+	lParams := make([]win.LPARAM, count) // original LPARAM of each current index
+	for pos, idx := range idxsWanted {
+		lvi := win.LVITEM{IItem: idx, Mask: co.LVIF_PARAM}
+		me.hWnd.SendMessage(co.LVM_GETITEM, 0, win.LPARAM(unsafe.Pointer(&lvi)))
+		lParams[idx] = lvi.LParam
+		lvi.LParam = win.LPARAM(pos)
+		me.hWnd.SendMessage(co.LVM_SETITEM, 0, win.LPARAM(unsafe.Pointer(&lvi)))
+	}
+
+	me.hWnd.SendMessage(co.LVM_SORTITEMS, 0, win.LPARAM(listViewRankCallback()))
+
+	for pos, idx := range idxsWanted {
+		lvi := win.LVITEM{IItem: int32(pos), Mask: co.LVIF_PARAM, LParam: lParams[idx]}
+		me.hWnd.SendMessage(co.LVM_SETITEM, 0, win.LPARAM(unsafe.Pointer(&lvi)))
+	}
+
+	if focused, hasFocused := me.Items.Focused(); hasFocused {
+		focused.EnsureVisible()
+	}
+}
+
+var _listViewRankCallback uintptr
+
+// Comparison callback for LVM_SORTITEMS, which orders the items by their
+// LPARAM values.
+func listViewRankCallback() uintptr {
+	if _listViewRankCallback != 0 {
+		return _listViewRankCallback
+	}
+
+	_listViewRankCallback = syscall.NewCallback(
+		func(lParamA, lParamB, _ uintptr) uintptr {
+			return uintptr(int(lParamA) - int(lParamB))
+		},
+	)
+	return _listViewRankCallback
+}
+
+// Tells whether the list view keeps its items sorted, with
+// co.LVS_SORTASCENDING or co.LVS_SORTDESCENDING styles.
+func (me *ListView) isSorted() bool {
+	style, _ := me.hWnd.GetWindowLongPtr(co.GWLP_STYLE)
+	return (co.LVS(style) & (co.LVS_SORTASCENDING | co.LVS_SORTDESCENDING)) != 0
+}
+
 func (me *ListView) assignOrClearHeader() {
 	hHeader, err := me.hWnd.SendMessage(co.LVM_GETHEADER, 0, 0)
 	if hHeader != 0 && err == nil { // the list has a header
@@ -260,6 +650,154 @@ func (me *ListView) SetExtendedStyle(doSet bool, style co.LVS_EX) *ListView {
 	return me
 }
 
+// Sets the callback which customizes the drawing of the items, processing the
+// [NM_CUSTOMDRAW] stages. In report view, the callback is called for each
+// column of each item; in other views, it's called once for each item, with
+// column 0.
+//
+// The callback receives the item, the column, the item state, and the drawing
+// parameters, already filled with the default values, which can be changed.
+//
+// The first call installs a handler for [NM_CUSTOMDRAW]. Since NM_CUSTOMDRAW
+// must return a value, only one handler is called: the last one added. So, if
+// you also add [EventsListView.NmCustomDraw] after calling this method, the
+// callback won't be called anymore.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var lv *ui.ListView // initialized somewhere
+//	var hBoldFont win.HFONT
+//
+//	lv.SetCustomDraw(func(
+//		item ui.ListViewItem, col int, state co.CDIS, draw *ui.ListViewDraw) {
+//
+//		if item.Text(1) == "ERROR" {
+//			draw.TextColor = win.RGB(200, 0, 0)
+//			draw.Font = hBoldFont
+//		}
+//	})
+//
+// [NM_CUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-customdraw-list-view
+func (me *ListView) SetCustomDraw(
+	fun func(item ListViewItem, columnIndex int, state co.CDIS, draw *ListViewDraw),
+) *ListView {
+	me.customDraw = fun
+	if fun != nil && !me.customDrawOn {
+		me.customDrawOn = true
+		me.events.NmCustomDraw(func(p *win.NMLVCUSTOMDRAW) co.CDRF {
+			if me.customDraw == nil {
+				return co.CDRF_DODEFAULT
+			}
+			return me.processCustomDraw(p)
+		})
+	}
+	if me.hWnd != 0 {
+		me.hWnd.InvalidateRect(nil, true)
+	}
+	return me
+}
+
+// Enables reordering the items by dragging them with the mouse. If the dragged
+// item is selected, all selected items are dragged along.
+//
+// While dragging, a drag image is displayed, the item under the cursor is
+// highlighted, and the control is scrolled when the cursor gets close to its
+// top or bottom edges. Esc cancels the operation.
+//
+// When the items are dropped, the callback is called, receiving the dragged
+// items and the item under the cursor. If the callback returns true, the
+// dragged items – with their texts, icons, states and data – are moved to the
+// position of the target item; if it returns false, nothing is done. The items
+// are reordered in place, so they keep their unique IDs. Passing nil disables
+// the dragging.
+//
+// Dragging is not available in list views created with co.LVS_SORTASCENDING or
+// co.LVS_SORTDESCENDING styles, since they keep their own order.
+//
+// In an owner-data list view, the items are not moved: the callback must
+// reorder the data itself, then return true so the list view is redrawn.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var lv *ui.ListView // initialized somewhere
+//
+//	lv.SetItemDrag(func(sources []ui.ListViewItem, target ui.ListViewItem) bool {
+//		return target.Text(0) != "Pinned"
+//	})
+func (me *ListView) SetItemDrag(
+	fun func(sources []ListViewItem, target ListViewItem) bool,
+) *ListView {
+	if fun == nil && me.drag.active {
+		me.endItemDrag()
+	}
+	me.itemDrag = fun
+	if fun != nil && !me.dragOn {
+		me.dragOn = true
+		me.itemDragMessageHandlers()
+	}
+	return me
+}
+
+// Sets the callback which provides the display data of each item of an
+// owner-data list view – that is, a list view created with co.LVS_OWNERDATA
+// style –, called on [LVN_GETDISPINFO]. The number of items is set with
+// [CollectionListViewItems.SetCount].
+//
+// Since the callback is called for each column of each item being displayed,
+// it should be fast. Expensive data retrieval can be anticipated by handling
+// [EventsListView.LvnODCacheHint].
+//
+// The first call also installs a handler for [LVN_ODFINDITEM], which does a
+// linear search on the texts of the first column returned by the callback.
+// Since LVN_ODFINDITEM must return a value, only one handler is called: the
+// last one added. So, for large lists, you may add your own
+// [EventsListView.LvnODFindItem] after calling this method, which will replace
+// the linear search.
+//
+// Selection and focus states are kept by the control, so
+// [CollectionListViewItems.Selected] and [CollectionListViewItems.Focused]
+// work as usual. However, [ListViewItem.Data] and [ListViewItem.SetData] are
+// not available, since owner-data items have no unique IDs.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	type LogLine struct {
+//		When, Text string
+//	}
+//
+//	var lv *ui.ListView // initialized somewhere
+//	var lines []LogLine
+//
+//	lv.SetVirtualSource(func(index int) ui.ListViewVirtualItem {
+//		return ui.ListViewVirtualItem{
+//			Texts:     []string{lines[index].When, lines[index].Text},
+//			IconIndex: -1,
+//		}
+//	})
+//	lv.Items.SetCount(uint(len(lines)), co.LVSICF_NOSCROLL)
+//
+// [LVN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-getdispinfo
+// [LVN_ODFINDITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-odfinditem
+func (me *ListView) SetVirtualSource(fun func(index int) ListViewVirtualItem) *ListView {
+	me.virtualSrc = fun
+	if fun != nil && !me.virtualFind {
+		me.virtualFind = true
+		me.events.LvnODFindItem(func(p *win.NMLVFINDITEM) int {
+			return me.findVirtualItem(p)
+		})
+	}
+	if me.hWnd != 0 {
+		me.hWnd.InvalidateRect(nil, true)
+	}
+	return me
+}
+
 // Enables or disables redrawing with [WM_SETREDRAW].
 //
 // Use this method to disable redrawing while you're updating multiple items at
@@ -297,6 +835,24 @@ func (me *ListView) View() co.LV_VIEW {
 	return co.LV_VIEW(viewRet)
 }
 
+// Drawing parameters of an item, or a column of an item, passed to the callback
+// set with [ListView.SetCustomDraw].
+type ListViewDraw struct {
+	TextColor  win.COLORREF                   // Text color; comes filled with the default one.
+	BkColor    win.COLORREF                   // Background color; comes filled with the default one.
+	Font       win.HFONT                      // Font to be used; if zero, the control font is used.
+	OwnerPaint func(hdc win.HDC, rc win.RECT) // If set, paints the whole item or column, skipping the default painting.
+}
+
+// Display data of an item of an owner-data [ListView], returned by the callback
+// set with [ListView.SetVirtualSource].
+type ListViewVirtualItem struct {
+	Texts     []string // Texts of each column; missing ones are displayed as empty.
+	IconIndex int      // Zero-based index of the icon in the image list, or -1 for none.
+	Indent    int      // Number of image widths to indent the item.
+	State     co.LVIS  // State image and overlay image bits, like co.LVIS_STATEIMAGEMASK.
+}
+
 // Options for [NewListView]; returned by [OptsListView].
 type VarOptsListView struct {
 	ctrlId      uint16
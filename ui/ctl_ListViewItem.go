@@ -305,6 +305,27 @@ func (me ListViewItem) SetText(columnIndex int, text string) ListViewItem {
 	return me
 }
 
+// Retrieves the bounding rectangle of the given column of the item, with
+// [LVM_GETSUBITEMRECT]. Note that, for column 0, co.LVIR_BOUNDS returns the
+// rectangle of the entire item.
+//
+// Panics on error.
+//
+// [LVM_GETSUBITEMRECT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getsubitemrect
+func (me ListViewItem) SubItemRect(columnIndex int, portion co.LVIR) win.RECT {
+	rcSubItem := win.RECT{
+		Top:  int32(columnIndex),
+		Left: int32(portion),
+	}
+
+	ret, err := me.owner.hWnd.SendMessage(co.LVM_GETSUBITEMRECT,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&rcSubItem)))
+	if err != nil || ret == 0 {
+		panic(fmt.Sprintf("LVM_GETSUBITEMRECT %d/%d failed.", me.index, columnIndex))
+	}
+	return rcSubItem // coordinates relative to the ListView
+}
+
 // Retrieves the text of the item, with [LVM_GETITEMTEXT].
 //
 // [LVM_GETITEMTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getitemtext
@@ -268,6 +268,24 @@ func (me *CollectionListViewItems) SelectedCount() uint {
 	return uint(ret)
 }
 
+// Sets the number of items with [LVM_SETITEMCOUNT].
+//
+// For owner-data list views – created with co.LVS_OWNERDATA style –, this is
+// the number of virtual items, whose data is provided by the callback set with
+// [ListView.SetVirtualSource]. For ordinary list views, it just preallocates
+// memory for the given number of items.
+//
+// Panics on error.
+//
+// [LVM_SETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setitemcount
+func (me *CollectionListViewItems) SetCount(count uint, flags co.LVSICF) {
+	ret, err := me.owner.hWnd.SendMessage(co.LVM_SETITEMCOUNT,
+		win.WPARAM(count), win.LPARAM(flags))
+	if err != nil || ret == 0 {
+		panic(fmt.Sprintf("LVM_SETITEMCOUNT %d failed.", count))
+	}
+}
+
 // Sorts the items according to the callback with [LVM_SORTITEMSEX].
 //
 // # Example
@@ -75,7 +75,7 @@ func (me *RadioButton) SelectAndTrigger() *RadioButton {
 // Returns the same object, so further operations can be chained.
 func (me *RadioButton) SetTextAndResize(text string) *RadioButton {
 	me.hWnd.SetWindowText(text)
-	boundBox, _ := calcTextBoundBoxWithCheck(utl.RemoveAccelAmpersands(text))
+	boundBox, _ := calcTextBoundBoxWithCheck(me.hWnd, utl.RemoveAccelAmpersands(text))
 	me.hWnd.SetWindowPos(win.HWND(0), 0, 0,
 		uint(boundBox.Cx), uint(boundBox.Cy), co.SWP_NOZORDER|co.SWP_NOMOVE)
 	return me
@@ -0,0 +1,342 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [rebar] control.
+//
+// Each band of the rebar hosts a child control, usually a [Toolbar] or a
+// [ComboBox]. The rebar automatically resizes itself when the parent window is
+// resized.
+//
+// Unless created with co.CCS_NOPARENTALIGN or co.CCS_VERT, the rebar height is
+// reserved in the parent layout: the positions of the anchored siblings are
+// relative to the area below the rebar (or above it, with co.CCS_BOTTOM), and
+// they're rearranged whenever the rebar height changes.
+//
+// [rebar]: https://learn.microsoft.com/en-us/windows/win32/controls/rebar-controls
+type Rebar struct {
+	_BaseCtrl
+	events EventsRebar
+	Bands  CollectionRebarBands // Methods to interact with the bands collection.
+}
+
+// Creates a new [Rebar] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	rebar := ui.NewRebar(
+//		wndOwner,
+//		ui.OptsRebar(),
+//	)
+//	toolbar := ui.NewToolbar(
+//		wndOwner,
+//		ui.OptsToolbar().
+//			CtrlStyle(co.TBSTYLE_FLAT | co.TBSTYLE_TRANSPARENT | co.TBSTYLE_LIST).
+//			WndStyle(co.WS_CHILD | co.WS_VISIBLE |
+//				co.WS(co.CCS_NORESIZE|co.CCS_NOPARENTALIGN|co.CCS_NODIVIDER)),
+//	)
+//	rebar.Bands.Add(toolbar, "", co.RBBS_GRIPPERALWAYS|co.RBBS_USECHEVRON)
+func NewRebar(parent Parent, opts *VarOptsRebar) *Rebar {
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &Rebar{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		events:    EventsRebar{opts.ctrlId, &parent.base().userEvents},
+	}
+	me.Bands.owner = me
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		win.InitCommonControlsEx(co.ICC_COOL_CLASSES)
+		me.createWindow(opts.wndExStyle, "ReBarWindow32", "",
+			opts.wndStyle|co.WS(opts.ctrlStyle), win.POINT{}, win.SIZE{}, parent, false)
+		if align := co.CCS(opts.ctrlStyle); (align & (co.CCS_NOPARENTALIGN | co.CCS_VERT)) == 0 {
+			parent.base().layout.AddDock(me.hWnd, (align&co.CCS_BOTTOM) == co.CCS_BOTTOM)
+		}
+		return 0 // ignored
+	})
+
+	parent.base().afterUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		me.Bands.insertPending() // all child controls are now created
+		return 0                 // ignored
+	})
+
+	parent.base().beforeUserEvents.WmSize(func(p WmSize) {
+		if p.Request() != co.SIZE_REQ_MINIMIZED {
+			me.hWnd.SendMessage(co.WM_SIZE, 0, 0) // rebar adjusts its own width
+		}
+	})
+
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.RBN_HEIGHTCHANGE, func(_ unsafe.Pointer) uintptr {
+		parent.base().layout.RearrangeNow(parent.Hwnd()) // siblings take the new height into account
+		return 0                                         // ignored
+	})
+
+	return me
+}
+
+// Exposes all the control notifications the can be handled.
+//
+// Panics if called after the control has been created.
+func (me *Rebar) On() *EventsRebar {
+	me.panicIfAddingEventAfterCreated()
+	return &me.events
+}
+
+// Retrieves the height of the rebar with [RB_GETBARHEIGHT].
+//
+// [RB_GETBARHEIGHT]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-getbarheight
+func (me *Rebar) Height() int {
+	ret, _ := me.hWnd.SendMessage(co.RB_GETBARHEIGHT, 0, 0)
+	return int(ret)
+}
+
+// Retrieves the current arrangement of the bands, which can be persisted and
+// later restored with [Rebar.RestoreLayout].
+//
+// # Example
+//
+//	var rebar *ui.Rebar // initialized somewhere
+//
+//	layout := rebar.Layout()
+//	blob, _ := json.Marshal(layout)
+func (me *Rebar) Layout() []RebarBandLayout {
+	bands := me.Bands.All()
+	layout := make([]RebarBandLayout, 0, len(bands))
+	for _, band := range bands {
+		rbi := band.info(co.RBBIM_STYLE | co.RBBIM_SIZE)
+		layout = append(layout, RebarBandLayout{
+			Id:      band.id,
+			Width:   uint(rbi.Cx),
+			NewLine: (rbi.FStyle & co.RBBS_BREAK) != 0,
+			Visible: (rbi.FStyle & co.RBBS_HIDDEN) == 0,
+		})
+	}
+	return layout
+}
+
+// Restores an arrangement of bands previously retrieved with [Rebar.Layout].
+// Bands not present in the layout are left untouched, and unknown band IDs
+// are ignored.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Rebar) RestoreLayout(layout []RebarBandLayout) *Rebar {
+	me.hWnd.SendMessage(co.WM_SETREDRAW, 0, 0)
+	defer func() {
+		me.hWnd.SendMessage(co.WM_SETREDRAW, 1, 0)
+		me.hWnd.InvalidateRect(nil, true)
+	}()
+
+	for destIdx, state := range layout {
+		band := RebarBand{me, state.Id}
+		curIdx := band.Index()
+		if curIdx == -1 {
+			continue // band no longer exists
+		}
+		if curIdx != destIdx && destIdx < int(me.Bands.Count()) {
+			me.hWnd.SendMessage(co.RB_MOVEBAND, win.WPARAM(curIdx), win.LPARAM(destIdx))
+		}
+
+		rbi := band.info(co.RBBIM_STYLE)
+		rbi.FMask = co.RBBIM_STYLE | co.RBBIM_SIZE
+		if state.NewLine {
+			rbi.FStyle |= co.RBBS_BREAK
+		} else {
+			rbi.FStyle &^= co.RBBS_BREAK
+		}
+		rbi.Cx = uint32(state.Width)
+		band.setInfo(&rbi)
+		band.Show(state.Visible)
+	}
+	return me
+}
+
+// Persisted state of a band, retrieved with [Rebar.Layout] and restored with
+// [Rebar.RestoreLayout].
+type RebarBandLayout struct {
+	Id      uint32 // Band ID, as returned by RebarBand.Id.
+	Width   uint   // Band width, in pixels.
+	NewLine bool   // Band starts a new row.
+	Visible bool   // Band is visible.
+}
+
+// Options for [NewRebar]; returned by [OptsRebar].
+type VarOptsRebar struct {
+	ctrlId     uint16
+	ctrlStyle  co.RBS
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+}
+
+// Options for [NewRebar].
+func OptsRebar() *VarOptsRebar {
+	return &VarOptsRebar{
+		ctrlStyle: co.RBS_VARHEIGHT | co.RBS_BANDBORDERS | co.RBS(co.CCS_NODIVIDER),
+		wndStyle:  co.WS_CHILD | co.WS_VISIBLE | co.WS_CLIPSIBLINGS | co.WS_CLIPCHILDREN,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsRebar) CtrlId(id uint16) *VarOptsRebar { o.ctrlId = id; return o }
+
+// Rebar control [style], passed to [win.CreateWindowEx].
+//
+// Defaults to co.RBS_VARHEIGHT | co.RBS_BANDBORDERS | co.RBS(co.CCS_NODIVIDER).
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/rebar-control-styles
+func (o *VarOptsRebar) CtrlStyle(s co.RBS) *VarOptsRebar { o.ctrlStyle = s; return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_CLIPSIBLINGS | co.WS_CLIPCHILDREN.
+func (o *VarOptsRebar) WndStyle(s co.WS) *VarOptsRebar { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT.
+func (o *VarOptsRebar) WndExStyle(s co.WS_EX) *VarOptsRebar { o.wndExStyle = s; return o }
+
+// Native [rebar] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [rebar]: https://learn.microsoft.com/en-us/windows/win32/controls/rebar-controls
+type EventsRebar struct {
+	ctrlId       uint16
+	parentEvents *EventsWindow
+}
+
+// [NM_RELEASEDCAPTURE] message handler.
+//
+// [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-rebar-
+func (me *EventsRebar) NmReleasedCapture(fun func()) {
+	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+		fun()
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_AUTOBREAK] message handler.
+//
+// [RBN_AUTOBREAK]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-autobreak
+func (me *EventsRebar) RbnAutoBreak(fun func()) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_AUTOBREAK, func(_ unsafe.Pointer) uintptr {
+		fun()
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_BEGINDRAG] message handler.
+//
+// [RBN_BEGINDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-begindrag
+func (me *EventsRebar) RbnBeginDrag(fun func(p *win.NMREBAR) bool) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
+		return utl.BoolToUintptr(fun((*win.NMREBAR)(p)))
+	})
+}
+
+// [RBN_CHEVRONPUSHED] message handler.
+//
+// The chevron is displayed for bands with co.RBBS_USECHEVRON style, when the
+// band is smaller than its ideal width.
+//
+// # Example
+//
+//	var rebar *ui.Rebar // initialized somewhere
+//
+//	rebar.On().RbnChevronPushed(func(p *win.NMREBARCHEVRON) {
+//		pt := win.POINT{X: p.Rc.Left, Y: p.Rc.Bottom}
+//		rebar.Hwnd().ClientToScreenPt(&pt)
+//		// display a popup menu with the hidden buttons at pt
+//	})
+//
+// [RBN_CHEVRONPUSHED]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-chevronpushed
+func (me *EventsRebar) RbnChevronPushed(fun func(p *win.NMREBARCHEVRON)) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_CHEVRONPUSHED, func(p unsafe.Pointer) uintptr {
+		fun((*win.NMREBARCHEVRON)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_CHILDSIZE] message handler.
+//
+// [RBN_CHILDSIZE]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-childsize
+func (me *EventsRebar) RbnChildSize(fun func(p *win.NMREBARCHILDSIZE)) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_CHILDSIZE, func(p unsafe.Pointer) uintptr {
+		fun((*win.NMREBARCHILDSIZE)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_DELETEDBAND] message handler.
+//
+// [RBN_DELETEDBAND]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-deletedband
+func (me *EventsRebar) RbnDeletedBand(fun func(p *win.NMREBAR)) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_DELETEDBAND, func(p unsafe.Pointer) uintptr {
+		fun((*win.NMREBAR)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_DELETINGBAND] message handler.
+//
+// [RBN_DELETINGBAND]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-deletingband
+func (me *EventsRebar) RbnDeletingBand(fun func(p *win.NMREBAR)) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_DELETINGBAND, func(p unsafe.Pointer) uintptr {
+		fun((*win.NMREBAR)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_ENDDRAG] message handler.
+//
+// [RBN_ENDDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-enddrag
+func (me *EventsRebar) RbnEndDrag(fun func(p *win.NMREBAR)) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_ENDDRAG, func(p unsafe.Pointer) uintptr {
+		fun((*win.NMREBAR)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_HEIGHTCHANGE] message handler.
+//
+// [RBN_HEIGHTCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-heightchange
+func (me *EventsRebar) RbnHeightChange(fun func()) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_HEIGHTCHANGE, func(_ unsafe.Pointer) uintptr {
+		fun()
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_LAYOUTCHANGED] message handler.
+//
+// This is the notification to handle if you want to persist the band layout
+// with [Rebar.Layout].
+//
+// [RBN_LAYOUTCHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-layoutchanged
+func (me *EventsRebar) RbnLayoutChanged(fun func()) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_LAYOUTCHANGED, func(_ unsafe.Pointer) uintptr {
+		fun()
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [RBN_MINMAX] message handler.
+//
+// [RBN_MINMAX]: https://learn.microsoft.com/en-us/windows/win32/controls/rbn-minmax
+func (me *EventsRebar) RbnMinMax(fun func() bool) {
+	me.parentEvents.WmNotify(me.ctrlId, co.RBN_MINMAX, func(_ unsafe.Pointer) uintptr {
+		return utl.BoolToUintptr(fun())
+	})
+}
@@ -0,0 +1,190 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// A band of a [rebar].
+//
+// Bands are identified by their ID, which remains the same even if the user
+// reorders the bands.
+//
+// [rebar]: https://learn.microsoft.com/en-us/windows/win32/controls/rebar-controls
+type RebarBand struct {
+	owner *Rebar
+	id    uint32
+}
+
+func (me RebarBand) info(mask co.RBBIM) win.REBARBANDINFO {
+	var rbi win.REBARBANDINFO
+	rbi.SetCbSize()
+	rbi.FMask = mask
+
+	ret, _ := me.owner.hWnd.SendMessage(co.RB_GETBANDINFO,
+		win.WPARAM(me.mustIndex()), win.LPARAM(unsafe.Pointer(&rbi)))
+	if ret == 0 {
+		panic("RB_GETBANDINFO failed.")
+	}
+	return rbi
+}
+
+func (me RebarBand) setInfo(rbi *win.REBARBANDINFO) {
+	ret, _ := me.owner.hWnd.SendMessage(co.RB_SETBANDINFO,
+		win.WPARAM(me.mustIndex()), win.LPARAM(unsafe.Pointer(rbi)))
+	if ret == 0 {
+		panic("RB_SETBANDINFO failed.")
+	}
+}
+
+func (me RebarBand) mustIndex() int {
+	if idx := me.Index(); idx != -1 {
+		return idx
+	}
+	panic("Rebar band was deleted.")
+}
+
+// Deletes the band with [RB_DELETEBAND]. The hosted child control is not
+// destroyed.
+//
+// [RB_DELETEBAND]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-deleteband
+func (me RebarBand) Delete() {
+	me.owner.hWnd.SendMessage(co.RB_DELETEBAND, win.WPARAM(me.mustIndex()), 0)
+}
+
+// Returns the unique ID of the band, which can be used with
+// [CollectionRebarBands.GetById].
+func (me RebarBand) Id() uint32 {
+	return me.id
+}
+
+// Retrieves the current zero-based index of the band with [RB_IDTOINDEX], or
+// -1 if the band was deleted.
+//
+// [RB_IDTOINDEX]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-idtoindex
+func (me RebarBand) Index() int {
+	idx, _ := me.owner.hWnd.SendMessage(co.RB_IDTOINDEX, win.WPARAM(me.id), 0)
+	return int(int32(idx))
+}
+
+// Returns true if the band is not hidden.
+func (me RebarBand) IsVisible() bool {
+	return (me.info(co.RBBIM_STYLE).FStyle & co.RBBS_HIDDEN) == 0
+}
+
+// Resizes the band to its largest size with [RB_MAXIMIZEBAND]. If ideal is
+// true, the band is resized to its ideal width instead.
+//
+// Returns the same band, so further operations can be chained.
+//
+// [RB_MAXIMIZEBAND]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-maximizeband
+func (me RebarBand) Maximize(ideal bool) RebarBand {
+	me.owner.hWnd.SendMessage(co.RB_MAXIMIZEBAND,
+		win.WPARAM(me.mustIndex()), win.LPARAM(utl.BoolToUintptr(ideal)))
+	return me
+}
+
+// Resizes the band to its smallest size with [RB_MINIMIZEBAND].
+//
+// Returns the same band, so further operations can be chained.
+//
+// [RB_MINIMIZEBAND]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-minimizeband
+func (me RebarBand) Minimize() RebarBand {
+	me.owner.hWnd.SendMessage(co.RB_MINIMIZEBAND, win.WPARAM(me.mustIndex()), 0)
+	return me
+}
+
+// Retrieves the bounding rectangle of the band, in rebar client coordinates,
+// with [RB_GETRECT].
+//
+// [RB_GETRECT]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-getrect
+func (me RebarBand) Rect() win.RECT {
+	var rc win.RECT
+	me.owner.hWnd.SendMessage(co.RB_GETRECT,
+		win.WPARAM(me.mustIndex()), win.LPARAM(unsafe.Pointer(&rc)))
+	return rc
+}
+
+// Sets the minimum size of the hosted child control with [RB_SETBANDINFO].
+//
+// Returns the same band, so further operations can be chained.
+//
+// [RB_SETBANDINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-setbandinfo
+func (me RebarBand) SetMinChildSize(cx, cy int) RebarBand {
+	var rbi win.REBARBANDINFO
+	rbi.SetCbSize()
+	rbi.FMask = co.RBBIM_CHILDSIZE
+	rbi.CxMinChild = uint32(cx)
+	rbi.CyMinChild = uint32(cy)
+	me.setInfo(&rbi)
+	return me
+}
+
+// Sets the text of the band with [RB_SETBANDINFO].
+//
+// Returns the same band, so further operations can be chained.
+//
+// [RB_SETBANDINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-setbandinfo
+func (me RebarBand) SetText(text string) RebarBand {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	var rbi win.REBARBANDINFO
+	rbi.SetCbSize()
+	rbi.FMask = co.RBBIM_TEXT
+	rbi.LpText = (*uint16)(wbuf.PtrAllowEmpty(text))
+	me.setInfo(&rbi)
+	return me
+}
+
+// Sets the width of the band with [RB_SETBANDWIDTH].
+//
+// Returns the same band, so further operations can be chained.
+//
+// [RB_SETBANDWIDTH]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-setbandwidth
+func (me RebarBand) SetWidth(cx int) RebarBand {
+	me.owner.hWnd.SendMessage(co.RB_SETBANDWIDTH,
+		win.WPARAM(me.mustIndex()), win.LPARAM(cx))
+	return me
+}
+
+// Shows or hides the band with [RB_SHOWBAND].
+//
+// Returns the same band, so further operations can be chained.
+//
+// [RB_SHOWBAND]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-showband
+func (me RebarBand) Show(show bool) RebarBand {
+	me.owner.hWnd.SendMessage(co.RB_SHOWBAND,
+		win.WPARAM(me.mustIndex()), win.LPARAM(utl.BoolToUintptr(show)))
+	return me
+}
+
+// Retrieves the text of the band with [RB_GETBANDINFO].
+//
+// [RB_GETBANDINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-getbandinfo
+func (me RebarBand) Text() string {
+	var buf [128]uint16 // arbitrary
+
+	var rbi win.REBARBANDINFO
+	rbi.SetCbSize()
+	rbi.FMask = co.RBBIM_TEXT
+	rbi.LpText = &buf[0]
+	rbi.Cch = uint32(len(buf))
+
+	me.owner.hWnd.SendMessage(co.RB_GETBANDINFO,
+		win.WPARAM(me.mustIndex()), win.LPARAM(unsafe.Pointer(&rbi)))
+	return wstr.DecodeSlice(buf[:])
+}
+
+// Retrieves the width of the band with [RB_GETBANDINFO].
+//
+// [RB_GETBANDINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-getbandinfo
+func (me RebarBand) Width() int {
+	return int(me.info(co.RBBIM_SIZE).Cx)
+}
@@ -0,0 +1,147 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+type _RebarBandPending struct {
+	id    uint32
+	child ChildControl
+	text  string
+	style co.RBBS
+}
+
+// The bands collection.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning [Rebar].
+type CollectionRebarBands struct {
+	owner   *Rebar
+	pending []_RebarBandPending // bands added before the rebar was created
+	nextId  uint32
+}
+
+func (me *CollectionRebarBands) insertPending() {
+	for _, p := range me.pending {
+		me.insert(p)
+	}
+	me.pending = nil
+}
+
+func (me *CollectionRebarBands) insert(p _RebarBandPending) {
+	hChild := p.child.Hwnd()
+
+	var rbi win.REBARBANDINFO
+	rbi.SetCbSize()
+	rbi.FMask = co.RBBIM_STYLE | co.RBBIM_CHILD | co.RBBIM_CHILDSIZE |
+		co.RBBIM_SIZE | co.RBBIM_ID | co.RBBIM_IDEALSIZE
+	rbi.FStyle = p.style | co.RBBS_CHILDEDGE
+	rbi.HwndChild = hChild
+	rbi.WID = p.id
+
+	if tb, ok := p.child.(*Toolbar); ok {
+		var sz win.SIZE // buttons are already added, so we can measure the toolbar
+		tb.hWnd.SendMessage(co.TB_GETMAXSIZE, 0, win.LPARAM(unsafe.Pointer(&sz)))
+		rbi.CyMinChild = uint32(sz.Cy)
+		rbi.Cx = uint32(sz.Cx)
+		rbi.CxIdeal = uint32(sz.Cx)
+		if (p.style & co.RBBS_USECHEVRON) == 0 {
+			rbi.CxMinChild = uint32(sz.Cx) // without chevron, toolbar can't be shrunk
+		}
+	} else {
+		rc, _ := hChild.GetWindowRect()
+		rbi.CxMinChild = uint32(rc.Right - rc.Left)
+		rbi.CyMinChild = uint32(rc.Bottom - rc.Top)
+		rbi.Cx = rbi.CxMinChild
+		rbi.CxIdeal = rbi.CxMinChild
+	}
+
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+	if p.text != "" {
+		rbi.FMask |= co.RBBIM_TEXT
+		rbi.LpText = (*uint16)(wbuf.PtrEmptyIsNil(p.text))
+	}
+
+	ret, _ := me.owner.hWnd.SendMessage(co.RB_INSERTBAND,
+		win.WPARAM(^uintptr(0)), win.LPARAM(unsafe.Pointer(&rbi))) // -1 appends at the end
+	if ret == 0 {
+		panic("RB_INSERTBAND failed.")
+	}
+}
+
+// Adds a new band with [RB_INSERTBAND], hosting the given child control. The
+// child control must have been created with the same parent of the rebar.
+//
+// If the child is a [Toolbar], its ideal size is used as the band size, and
+// the co.RBBS_USECHEVRON style will display a chevron when the band is
+// shrunk. Note that the toolbar should be created with co.CCS_NORESIZE,
+// co.CCS_NOPARENTALIGN and co.CCS_NODIVIDER styles, otherwise it will try to
+// position itself. For other controls, the current control size is used as the
+// band minimum size.
+//
+// If called before the rebar is created, the band will be added right after
+// the parent window is created.
+//
+// Panics on error.
+//
+// [RB_INSERTBAND]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-insertband
+func (me *CollectionRebarBands) Add(child ChildControl, text string, style co.RBBS) RebarBand {
+	me.nextId++
+	p := _RebarBandPending{me.nextId, child, text, style}
+	if me.owner.hWnd == 0 {
+		me.pending = append(me.pending, p)
+	} else {
+		me.insert(p)
+	}
+	return RebarBand{me.owner, p.id}
+}
+
+// Returns all bands.
+func (me *CollectionRebarBands) All() []RebarBand {
+	nBands := me.Count()
+	bands := make([]RebarBand, 0, nBands)
+	for i := uint(0); i < nBands; i++ {
+		bands = append(bands, me.Get(int(i)))
+	}
+	return bands
+}
+
+// Retrieves the number of bands with [RB_GETBANDCOUNT].
+//
+// [RB_GETBANDCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/rb-getbandcount
+func (me *CollectionRebarBands) Count() uint {
+	count, _ := me.owner.hWnd.SendMessage(co.RB_GETBANDCOUNT, 0, 0)
+	return uint(count)
+}
+
+// Returns the band at the given zero-based index.
+//
+// Panics on error.
+func (me *CollectionRebarBands) Get(index int) RebarBand {
+	var rbi win.REBARBANDINFO
+	rbi.SetCbSize()
+	rbi.FMask = co.RBBIM_ID
+
+	ret, _ := me.owner.hWnd.SendMessage(co.RB_GETBANDINFO,
+		win.WPARAM(index), win.LPARAM(unsafe.Pointer(&rbi)))
+	if ret == 0 {
+		panic("RB_GETBANDINFO failed.")
+	}
+	return RebarBand{me.owner, rbi.WID}
+}
+
+// Returns the band with the given ID, as returned by [RebarBand.Id], if any.
+func (me *CollectionRebarBands) GetById(id uint32) (RebarBand, bool) {
+	band := RebarBand{me.owner, id}
+	if band.Index() == -1 {
+		return RebarBand{}, false
+	}
+	return band, true
+}
@@ -0,0 +1,650 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Native [rich edit] control, version 4.1, loaded from msftedit.dll.
+//
+// [rich edit]: https://learn.microsoft.com/en-us/windows/win32/controls/about-rich-edit-controls
+type RichEdit struct {
+	_BaseCtrl
+	events EventsRichEdit
+}
+
+// Creates a new [RichEdit] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	rich := ui.NewRichEdit(
+//		wndOwner,
+//		ui.OptsRichEdit().
+//			Position(ui.Dpi(20, 10)).
+//			Size(ui.Dpi(300, 200)),
+//	)
+func NewRichEdit(parent Parent, opts *VarOptsRichEdit) *RichEdit {
+	dll.LoadDll(dll.MSFTEDIT) // registers the RICHEDIT50W window class
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &RichEdit{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		events:    EventsRichEdit{opts.ctrlId, &parent.base().userEvents},
+	}
+
+	parent.base().beforeUserEvents.WmCreate(func(_ WmCreate) int {
+		me.createWindow(opts.wndExStyle, "RICHEDIT50W", opts.text,
+			opts.wndStyle|co.WS(opts.ctrlStyle), opts.position, opts.size, parent, true)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		me.SetEventMask(opts.eventMask)
+		if opts.autoUrlDetect {
+			me.hWnd.SendMessage(co.EM_AUTOURLDETECT, 1, 0) // AURL_ENABLEURL
+		}
+		return 0 // ignored
+	})
+
+	return me
+}
+
+// Instantiates a new [RichEdit] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem]. The dialog resource must use the "RICHEDIT50W" class.
+//
+// The event mask is set to co.ENM_CHANGE | co.ENM_SELCHANGE | co.ENM_LINK.
+//
+// # Example
+//
+//	const ID_RICH uint16 = 0x100
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	rich := ui.NewRichEditDlg(
+//		wndOwner, ID_RICH, ui.LAY_NONE_NONE)
+func NewRichEditDlg(parent Parent, ctrlId uint16, layout LAY) *RichEdit {
+	dll.LoadDll(dll.MSFTEDIT) // must be loaded before the dialog is created
+	me := &RichEdit{
+		_BaseCtrl: newBaseCtrl(ctrlId),
+		events:    EventsRichEdit{ctrlId, &parent.base().userEvents},
+	}
+
+	parent.base().beforeUserEvents.WmInitDialog(func(_ WmInitDialog) bool {
+		me.assignDialog(parent)
+		parent.base().layout.Add(parent, me.hWnd, layout)
+		me.SetEventMask(co.ENM_CHANGE | co.ENM_SELCHANGE | co.ENM_LINK)
+		return true // ignored
+	})
+
+	return me
+}
+
+// Exposes all the control notifications the can be handled.
+//
+// Panics if called after the control has been created.
+func (me *RichEdit) On() *EventsRichEdit {
+	me.panicIfAddingEventAfterCreated()
+	return &me.events
+}
+
+// Retrieves the character formatting with [EM_GETCHARFORMAT]. If selection is
+// true, returns the formatting of the current selection; otherwise, returns
+// the default formatting of the control.
+//
+// [EM_GETCHARFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-getcharformat
+func (me *RichEdit) CharFormat(selection bool) win.CHARFORMAT2 {
+	var cf win.CHARFORMAT2
+	cf.SetCbSize()
+	cf.DwMask = co.CFM_ALL | co.CFM_BACKCOLOR | co.CFM_WEIGHT
+	scope := co.SCF_DEFAULT
+	if selection {
+		scope = co.SCF_SELECTION
+	}
+	me.hWnd.SendMessage(co.EM_GETCHARFORMAT,
+		win.WPARAM(scope), win.LPARAM(unsafe.Pointer(&cf)))
+	return cf
+}
+
+// Retrieves the event mask with [EM_GETEVENTMASK].
+//
+// [EM_GETEVENTMASK]: https://learn.microsoft.com/en-us/windows/win32/controls/em-geteventmask
+func (me *RichEdit) EventMask() co.ENM {
+	ret, _ := me.hWnd.SendMessage(co.EM_GETEVENTMASK, 0, 0)
+	return co.ENM(ret)
+}
+
+// Searches for the given text with [EM_FINDTEXTEX], within the given range of
+// character positions. If endPos is -1, the search goes until the end of the
+// text. Use co.FR_DOWN to search forward.
+//
+// Returns the range of the found text, if any.
+//
+// # Example
+//
+//	var rich *ui.RichEdit // initialized somewhere
+//
+//	if start, end, found := rich.Find("foo", co.FR_DOWN, 0, -1); found {
+//		rich.SetSelection(start, end)
+//	}
+//
+// [EM_FINDTEXTEX]: https://learn.microsoft.com/en-us/windows/win32/controls/em-findtextex
+func (me *RichEdit) Find(text string, flags co.FR, startPos, endPos int) (int, int, bool) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	fte := win.FINDTEXTEX{
+		Chrg:      win.CHARRANGE{CpMin: int32(startPos), CpMax: int32(endPos)},
+		LpstrText: (*uint16)(wbuf.PtrAllowEmpty(text)),
+	}
+	ret, _ := me.hWnd.SendMessage(co.EM_FINDTEXTEXW,
+		win.WPARAM(flags), win.LPARAM(unsafe.Pointer(&fte)))
+	if int32(ret) == -1 {
+		return -1, -1, false // not found
+	}
+	return int(fte.ChrgText.CpMin), int(fte.ChrgText.CpMax), true
+}
+
+// Sets the maximum number of characters with [EM_EXLIMITTEXT].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_EXLIMITTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-exlimittext
+func (me *RichEdit) LimitText(maxChars uint) *RichEdit {
+	me.hWnd.SendMessage(co.EM_EXLIMITTEXT, 0, win.LPARAM(maxChars))
+	return me
+}
+
+// Retrieves the paragraph formatting of the current selection with
+// [EM_GETPARAFORMAT].
+//
+// [EM_GETPARAFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-getparaformat
+func (me *RichEdit) ParaFormat() win.PARAFORMAT2 {
+	var pf win.PARAFORMAT2
+	pf.SetCbSize()
+	me.hWnd.SendMessage(co.EM_GETPARAFORMAT, 0, win.LPARAM(unsafe.Pointer(&pf)))
+	return pf
+}
+
+// Replaces all occurrences of the given text, returning the number of
+// replacements. Uses [EM_FINDTEXTEX] and [EM_REPLACESEL].
+//
+// The flags co.FR_MATCHCASE and co.FR_WHOLEWORD can be used; co.FR_DOWN is
+// always added.
+//
+// [EM_FINDTEXTEX]: https://learn.microsoft.com/en-us/windows/win32/controls/em-findtextex
+// [EM_REPLACESEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-replacesel
+func (me *RichEdit) ReplaceAll(text, replacement string, flags co.FR) uint {
+	if text == "" {
+		return 0
+	}
+
+	count := uint(0)
+	pos := 0
+	for {
+		start, end, found := me.Find(text, flags|co.FR_DOWN, pos, -1)
+		if !found {
+			break
+		}
+		me.SetSelection(start, end)
+		me.ReplaceSelection(replacement, true)
+		pos, _ = me.Selection() // caret is now right after the replaced text
+		count++
+	}
+	return count
+}
+
+// Replaces the current selection with the given text, with [EM_REPLACESEL].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_REPLACESEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-replacesel
+func (me *RichEdit) ReplaceSelection(text string, canUndo bool) *RichEdit {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	me.hWnd.SendMessage(co.EM_REPLACESEL,
+		win.WPARAM(utl.BoolToUintptr(canUndo)), win.LPARAM(wbuf.PtrAllowEmpty(text)))
+	return me
+}
+
+// Retrieves the currently selected text with [EM_GETSELTEXT].
+//
+// [EM_GETSELTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-getseltext
+func (me *RichEdit) SelectedText() string {
+	start, end := me.Selection()
+	if start == end {
+		return ""
+	}
+
+	recvBuf := wstr.NewBufDecoder(uint(end-start) + 1)
+	defer recvBuf.Free()
+
+	me.hWnd.SendMessage(co.EM_GETSELTEXT, 0, win.LPARAM(recvBuf.UnsafePtr()))
+	return recvBuf.String()
+}
+
+// Retrieves the starting and ending character positions of the current
+// selection with [EM_EXGETSEL].
+//
+// [EM_EXGETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-exgetsel
+func (me *RichEdit) Selection() (int, int) {
+	var cr win.CHARRANGE
+	me.hWnd.SendMessage(co.EM_EXGETSEL, 0, win.LPARAM(unsafe.Pointer(&cr)))
+	return int(cr.CpMin), int(cr.CpMax)
+}
+
+// Sets the background color with [EM_SETBKGNDCOLOR]. If useSysColor is true,
+// the system window color is used, and color is ignored.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_SETBKGNDCOLOR]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setbkgndcolor
+func (me *RichEdit) SetBkgndColor(useSysColor bool, color win.COLORREF) *RichEdit {
+	me.hWnd.SendMessage(co.EM_SETBKGNDCOLOR,
+		win.WPARAM(utl.BoolToUintptr(useSysColor)), win.LPARAM(color))
+	return me
+}
+
+// Sets the character formatting with [EM_SETCHARFORMAT]. Only the members
+// flagged in cf.DwMask are applied.
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics on error.
+//
+// # Example
+//
+//	var rich *ui.RichEdit // initialized somewhere
+//
+//	var cf win.CHARFORMAT2
+//	cf.SetCbSize()
+//	cf.DwMask = co.CFM_BOLD | co.CFM_COLOR
+//	cf.DwEffects = co.CFE_BOLD
+//	cf.CrTextColor = win.RGB(0xff, 0, 0)
+//
+//	rich.SetCharFormat(&cf, co.SCF_SELECTION)
+//
+// [EM_SETCHARFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setcharformat
+func (me *RichEdit) SetCharFormat(cf *win.CHARFORMAT2, scope co.SCF) *RichEdit {
+	ret, _ := me.hWnd.SendMessage(co.EM_SETCHARFORMAT,
+		win.WPARAM(scope), win.LPARAM(unsafe.Pointer(cf)))
+	if ret == 0 {
+		panic("EM_SETCHARFORMAT failed.")
+	}
+	return me
+}
+
+// Sets the notifications sent to the parent window with [EM_SETEVENTMASK].
+//
+// Note that EN_SELCHANGE and EN_LINK notifications are only sent if the
+// respective co.ENM_SELCHANGE and co.ENM_LINK flags are set.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_SETEVENTMASK]: https://learn.microsoft.com/en-us/windows/win32/controls/em-seteventmask
+func (me *RichEdit) SetEventMask(mask co.ENM) *RichEdit {
+	me.hWnd.SendMessage(co.EM_SETEVENTMASK, 0, win.LPARAM(mask))
+	return me
+}
+
+// Sets the paragraph formatting of the current selection with
+// [EM_SETPARAFORMAT]. Only the members flagged in pf.DwMask are applied.
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics on error.
+//
+// [EM_SETPARAFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setparaformat
+func (me *RichEdit) SetParaFormat(pf *win.PARAFORMAT2) *RichEdit {
+	ret, _ := me.hWnd.SendMessage(co.EM_SETPARAFORMAT,
+		0, win.LPARAM(unsafe.Pointer(pf)))
+	if ret == 0 {
+		panic("EM_SETPARAFORMAT failed.")
+	}
+	return me
+}
+
+// Selects a range of characters with [EM_EXSETSEL].
+//
+// If the start is 0 and the end is -1, all the text is selected. If the start
+// is -1, any current selection is deselected.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_EXSETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-exsetsel
+func (me *RichEdit) SetSelection(startPos, endPos int) *RichEdit {
+	cr := win.CHARRANGE{CpMin: int32(startPos), CpMax: int32(endPos)}
+	me.hWnd.SendMessage(co.EM_EXSETSEL, 0, win.LPARAM(unsafe.Pointer(&cr)))
+	return me
+}
+
+// Calls [win.HWND.SetWindowText].
+//
+// Returns the same object, so further operations can be chained.
+func (me *RichEdit) SetText(text string) *RichEdit {
+	me.hWnd.SetWindowText(text)
+	return me
+}
+
+// Replaces the contents of the control with data read from the given
+// io.Reader, with [EM_STREAMIN]. The format is usually co.SF_RTF or
+// co.SF_TEXT; add co.SFF_SELECTION to replace only the current selection.
+//
+// Returns the number of characters read.
+//
+// # Example
+//
+//	var rich *ui.RichEdit // initialized somewhere
+//
+//	f, _ := os.Open("C:\\Temp\\foo.rtf")
+//	defer f.Close()
+//
+//	rich.StreamIn(f, co.SF_RTF)
+//
+// [EM_STREAMIN]: https://learn.microsoft.com/en-us/windows/win32/controls/em-streamin
+func (me *RichEdit) StreamIn(r io.Reader, format co.SF) (uint, error) {
+	pPack := &_RichEditStreamPack{r: r}
+	return me.stream(co.EM_STREAMIN, pPack, format)
+}
+
+// Writes the contents of the control to the given io.Writer, with
+// [EM_STREAMOUT]. The format is usually co.SF_RTF or co.SF_TEXT; add
+// co.SFF_SELECTION to write only the current selection.
+//
+// Returns the number of characters written.
+//
+// # Example
+//
+//	var rich *ui.RichEdit // initialized somewhere
+//
+//	var buf bytes.Buffer
+//	rich.StreamOut(&buf, co.SF_RTF)
+//	println(buf.String())
+//
+// [EM_STREAMOUT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-streamout
+func (me *RichEdit) StreamOut(w io.Writer, format co.SF) (uint, error) {
+	pPack := &_RichEditStreamPack{w: w}
+	return me.stream(co.EM_STREAMOUT, pPack, format)
+}
+
+func (me *RichEdit) stream(msg co.WM, pPack *_RichEditStreamPack, format co.SF) (uint, error) {
+	msgName := "EM_STREAMIN"
+	if msg == co.EM_STREAMOUT {
+		msgName = "EM_STREAMOUT"
+	}
+
+	var es win.EDITSTREAM
+	es.SetDwCookie(uintptr(unsafe.Pointer(pPack)))
+	es.SetPfnCallback(richEditStreamCallback())
+	ret, _ := me.hWnd.SendMessage(msg,
+		win.WPARAM(format), win.LPARAM(unsafe.Pointer(&es)))
+	runtime.KeepAlive(pPack)
+
+	if pPack.err != nil {
+		return uint(ret), fmt.Errorf("%s: %w", msgName, pPack.err)
+	} else if es.DwError != 0 {
+		return uint(ret), fmt.Errorf("%s failed with error %d", msgName, es.DwError)
+	}
+	return uint(ret), nil
+}
+
+type _RichEditStreamPack struct {
+	r   io.Reader // used by EM_STREAMIN
+	w   io.Writer // used by EM_STREAMOUT
+	err error
+}
+
+var (
+	_richEditStreamCallback     uintptr
+	_richEditStreamCallbackOnce sync.Once
+)
+
+func richEditStreamCallback() uintptr {
+	_richEditStreamCallbackOnce.Do(func() {
+		_richEditStreamCallback = syscall.NewCallback(
+			func(dwCookie, pbBuff, cb, pcb uintptr) uintptr {
+				pPack := (*_RichEditStreamPack)(unsafe.Pointer(dwCookie))
+				buf := unsafe.Slice((*byte)(unsafe.Pointer(pbBuff)), int(int32(cb)))
+
+				var n int
+				var err error
+				if pPack.r != nil {
+					n, err = io.ReadFull(pPack.r, buf)
+					if err == io.EOF || err == io.ErrUnexpectedEOF {
+						err = nil // zero bytes will signal the end of the stream
+					}
+				} else {
+					n, err = pPack.w.Write(buf)
+				}
+
+				*(*int32)(unsafe.Pointer(pcb)) = int32(n)
+				if err != nil {
+					pPack.err = err
+					return 1 // abort the operation
+				}
+				return 0
+			},
+		)
+	})
+	return _richEditStreamCallback
+}
+
+// Calls [win.HWND.GetWindowText].
+func (me *RichEdit) Text() string {
+	t, _ := me.hWnd.GetWindowText()
+	return t
+}
+
+// Options for [NewRichEdit]; returned by [OptsRichEdit].
+type VarOptsRichEdit struct {
+	ctrlId     uint16
+	layout     LAY
+	text       string
+	position   win.POINT
+	size       win.SIZE
+	ctrlStyle  co.ES
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+
+	eventMask     co.ENM
+	autoUrlDetect bool
+}
+
+// Options for [NewRichEdit].
+func OptsRichEdit() *VarOptsRichEdit {
+	return &VarOptsRichEdit{
+		size:       win.SIZE{Cx: int32(DpiX(200)), Cy: int32(DpiY(120))},
+		ctrlStyle:  co.ES_MULTILINE | co.ES_WANTRETURN | co.ES_AUTOVSCROLL | co.ES_NOHIDESEL,
+		wndStyle:   co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL,
+		wndExStyle: co.WS_EX_LEFT | co.WS_EX_CLIENTEDGE,
+		eventMask:  co.ENM_CHANGE | co.ENM_SELCHANGE | co.ENM_LINK,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsRichEdit) CtrlId(id uint16) *VarOptsRichEdit { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsRichEdit) Layout(l LAY) *VarOptsRichEdit { o.layout = l; return o }
+
+// Text to be displayed, passed to [win.CreateWindowEx].
+//
+// Defaults to empty string.
+func (o *VarOptsRichEdit) Text(t string) *VarOptsRichEdit { o.text = t; return o }
+
+// Position coordinates within parent window client area, in pixels, passed to
+// [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsRichEdit) Position(x, y int) *VarOptsRichEdit {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control size in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(200, 120).
+func (o *VarOptsRichEdit) Size(cx int, cy int) *VarOptsRichEdit {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Rich edit control [style], passed to [win.CreateWindowEx].
+//
+// Defaults to co.ES_MULTILINE | co.ES_WANTRETURN | co.ES_AUTOVSCROLL | co.ES_NOHIDESEL.
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/rich-edit-control-styles
+func (o *VarOptsRichEdit) CtrlStyle(s co.ES) *VarOptsRichEdit { o.ctrlStyle = s; return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL.
+func (o *VarOptsRichEdit) WndStyle(s co.WS) *VarOptsRichEdit { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT | co.WS_EX_CLIENTEDGE.
+func (o *VarOptsRichEdit) WndExStyle(s co.WS_EX) *VarOptsRichEdit { o.wndExStyle = s; return o }
+
+// Notifications sent to the parent window, set with [EM_SETEVENTMASK].
+//
+// Defaults to co.ENM_CHANGE | co.ENM_SELCHANGE | co.ENM_LINK.
+//
+// [EM_SETEVENTMASK]: https://learn.microsoft.com/en-us/windows/win32/controls/em-seteventmask
+func (o *VarOptsRichEdit) EventMask(m co.ENM) *VarOptsRichEdit { o.eventMask = m; return o }
+
+// Automatically detects and formats URLs with [EM_AUTOURLDETECT], so they
+// generate EN_LINK notifications.
+//
+// Defaults to false.
+//
+// [EM_AUTOURLDETECT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-autourldetect
+func (o *VarOptsRichEdit) AutoUrlDetect(d bool) *VarOptsRichEdit { o.autoUrlDetect = d; return o }
+
+// Native [rich edit] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [rich edit]: https://learn.microsoft.com/en-us/windows/win32/controls/about-rich-edit-controls
+type EventsRichEdit struct {
+	ctrlId       uint16
+	parentEvents *EventsWindow
+}
+
+// [EN_CHANGE] message handler.
+//
+// Requires the co.ENM_CHANGE event mask.
+//
+// [EN_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-change
+func (me *EventsRichEdit) EnChange(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_CHANGE, fun)
+}
+
+// [EN_ERRSPACE] message handler.
+//
+// [EN_ERRSPACE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-errspace
+func (me *EventsRichEdit) EnErrSpace(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_ERRSPACE, fun)
+}
+
+// [EN_HSCROLL] message handler.
+//
+// Requires the co.ENM_SCROLL event mask.
+//
+// [EN_HSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-hscroll
+func (me *EventsRichEdit) EnHScroll(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_HSCROLL, fun)
+}
+
+// [EN_KILLFOCUS] message handler.
+//
+// [EN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-killfocus
+func (me *EventsRichEdit) EnKillFocus(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_KILLFOCUS, fun)
+}
+
+// [EN_LINK] message handler.
+//
+// Requires the co.ENM_LINK event mask. Return true to prevent the control from
+// processing the mouse or cursor message.
+//
+// # Example
+//
+//	var rich *ui.RichEdit // initialized somewhere
+//
+//	rich.On().EnLink(func(p *win.ENLINK) bool {
+//		if p.Msg == co.WM_LBUTTONUP {
+//			rich.SetSelection(int(p.Chrg.CpMin), int(p.Chrg.CpMax))
+//			println(rich.SelectedText())
+//		}
+//		return false
+//	})
+//
+// [EN_LINK]: https://learn.microsoft.com/en-us/windows/win32/controls/en-link
+func (me *EventsRichEdit) EnLink(fun func(p *win.ENLINK) bool) {
+	me.parentEvents.WmNotify(me.ctrlId, co.EN_LINK, func(p unsafe.Pointer) uintptr {
+		return utl.BoolToUintptr(fun((*win.ENLINK)(p)))
+	})
+}
+
+// [EN_MAXTEXT] message handler.
+//
+// [EN_MAXTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/en-maxtext
+func (me *EventsRichEdit) EnMaxText(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_MAXTEXT, fun)
+}
+
+// [EN_SELCHANGE] message handler.
+//
+// Requires the co.ENM_SELCHANGE event mask.
+//
+// [EN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-selchange
+func (me *EventsRichEdit) EnSelChange(fun func(p *win.SELCHANGE)) {
+	me.parentEvents.WmNotify(me.ctrlId, co.EN_SELCHANGE, func(p unsafe.Pointer) uintptr {
+		fun((*win.SELCHANGE)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [EN_SETFOCUS] message handler.
+//
+// [EN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-setfocus
+func (me *EventsRichEdit) EnSetFocus(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_SETFOCUS, fun)
+}
+
+// [EN_UPDATE] message handler.
+//
+// Requires the co.ENM_UPDATE event mask.
+//
+// [EN_UPDATE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-update
+func (me *EventsRichEdit) EnUpdate(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_UPDATE, fun)
+}
+
+// [EN_VSCROLL] message handler.
+//
+// Requires the co.ENM_SCROLL event mask.
+//
+// [EN_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-vscroll
+func (me *EventsRichEdit) EnVScroll(fun func()) {
+	me.parentEvents.WmCommand(me.ctrlId, co.EN_VSCROLL, fun)
+}
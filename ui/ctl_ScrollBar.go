@@ -0,0 +1,352 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [scroll bar] control.
+//
+// The scroll box position is automatically updated when the user interacts
+// with the control.
+//
+// [scroll bar]: https://learn.microsoft.com/en-us/windows/win32/controls/scroll-bars
+type ScrollBar struct {
+	_BaseCtrl
+	events   EventsScrollBar
+	lineSize int
+}
+
+// Creates a new [ScrollBar] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	sb := ui.NewScrollBar(
+//		wndOwner,
+//		ui.OptsScrollBar().
+//			Position(ui.Dpi(20, 10)).
+//			CtrlStyle(co.SBS_VERT).
+//			Width(ui.DpiX(17)).
+//			Height(ui.DpiY(120)).
+//			Range(0, 1000),
+//	)
+func NewScrollBar(parent Parent, opts *VarOptsScrollBar) *ScrollBar {
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &ScrollBar{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		lineSize:  opts.lineSize,
+	}
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		me.createWindow(opts.wndExStyle, "SCROLLBAR", "",
+			opts.wndStyle|co.WS(opts.ctrlStyle), opts.position, opts.size, parent, false)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		me.SetRange(opts.rangeMin, opts.rangeMax).
+			SetPageSize(opts.pageSize)
+		return 0 // ignored
+	})
+
+	me.defaultMessageHandlers(parent)
+	return me
+}
+
+// Instantiates a new [ScrollBar] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+//
+// # Example
+//
+//	const ID_SB uint16 = 0x100
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	sb := ui.NewScrollBarDlg(
+//		wndOwner, ID_SB, ui.LAY_NONE_NONE)
+func NewScrollBarDlg(parent Parent, ctrlId uint16, layout LAY) *ScrollBar {
+	me := &ScrollBar{
+		_BaseCtrl: newBaseCtrl(ctrlId),
+		lineSize:  1,
+	}
+
+	parent.base().beforeUserEvents.WmInitDialog(func(_ WmInitDialog) bool {
+		me.assignDialog(parent)
+		parent.base().layout.Add(parent, me.hWnd, layout)
+		return true // ignored
+	})
+
+	me.defaultMessageHandlers(parent)
+	return me
+}
+
+func (me *ScrollBar) defaultMessageHandlers(parent Parent) {
+	// WM_HSCROLL and WM_VSCROLL are sent to the parent, and identified only by
+	// the HWND of the scroll bar, so we must filter them here.
+	handler := func(p Wm) uintptr {
+		if me.hWnd == 0 || win.HWND(p.LParam) != me.hWnd {
+			return 0 // not for us
+		}
+
+		parm := WmScroll{Raw: p}
+		si := me.scrollInfo(co.SIF_ALL)
+		if newPos := scrollPosFromRequest(&si, parm.Request(), me.lineSize); newPos != si.NPos {
+			me.SetPos(int(newPos))
+		}
+
+		funs := me.events.vScroll
+		if p.Msg == co.WM_HSCROLL {
+			funs = me.events.hScroll
+		}
+		for _, fun := range funs {
+			fun(parm)
+		}
+		return 0 // ignored
+	}
+
+	parent.base().beforeUserEvents.Wm(co.WM_HSCROLL, handler)
+	parent.base().beforeUserEvents.Wm(co.WM_VSCROLL, handler)
+}
+
+func (me *ScrollBar) scrollInfo(mask co.SIF) win.SCROLLINFO {
+	var si win.SCROLLINFO
+	si.SetCbSize()
+	si.FMask = mask
+	me.hWnd.GetScrollInfo(co.SB_TYPE_CTL, &si)
+	return si
+}
+
+// Computes the new scroll box position after a scroll request, clamped to the
+// scrollable range. The SCROLLINFO must have been retrieved with SIF_ALL.
+func scrollPosFromRequest(si *win.SCROLLINFO, req co.SB_REQ, lineSize int) int32 {
+	pos := si.NPos
+	switch req {
+	case co.SB_REQ_LINEUP:
+		pos -= int32(lineSize)
+	case co.SB_REQ_LINEDOWN:
+		pos += int32(lineSize)
+	case co.SB_REQ_PAGEUP:
+		pos -= int32(si.NPage)
+	case co.SB_REQ_PAGEDOWN:
+		pos += int32(si.NPage)
+	case co.SB_REQ_THUMBPOSITION, co.SB_REQ_THUMBTRACK:
+		pos = si.NTrackPos
+	case co.SB_REQ_TOP:
+		pos = si.NMin
+	case co.SB_REQ_BOTTOM:
+		pos = si.NMax
+	}
+
+	maxPos := si.NMax - int32(si.NPage) + 1 // the page must fit within the range
+	if si.NPage == 0 {
+		maxPos = si.NMax
+	}
+	if pos > maxPos {
+		pos = maxPos
+	}
+	if pos < si.NMin {
+		pos = si.NMin
+	}
+	return pos
+}
+
+// Exposes all the control notifications the can be handled.
+//
+// Panics if called after the control has been created.
+func (me *ScrollBar) On() *EventsScrollBar {
+	me.panicIfAddingEventAfterCreated()
+	return &me.events
+}
+
+// Retrieves the page size with [win.HWND.GetScrollInfo].
+func (me *ScrollBar) PageSize() uint {
+	return uint(me.scrollInfo(co.SIF_PAGE).NPage)
+}
+
+// Retrieves the current position of the scroll box with
+// [win.HWND.GetScrollInfo].
+func (me *ScrollBar) Pos() int {
+	return int(me.scrollInfo(co.SIF_POS).NPos)
+}
+
+// Retrieves the minimum and maximum scrolling positions with
+// [win.HWND.GetScrollInfo].
+func (me *ScrollBar) Range() (int, int) {
+	si := me.scrollInfo(co.SIF_RANGE)
+	return int(si.NMin), int(si.NMax)
+}
+
+// Sets the amount scrolled when the user clicks the arrows.
+//
+// Returns the same object, so further operations can be chained.
+func (me *ScrollBar) SetLineSize(lineSize int) *ScrollBar {
+	me.lineSize = lineSize
+	return me
+}
+
+// Sets the page size with [win.HWND.SetScrollInfo]. The page size is used to
+// compute the proportional size of the scroll box.
+//
+// Returns the same object, so further operations can be chained.
+func (me *ScrollBar) SetPageSize(pageSize uint) *ScrollBar {
+	var si win.SCROLLINFO
+	si.SetCbSize()
+	si.FMask = co.SIF_PAGE
+	si.NPage = uint32(pageSize)
+	me.hWnd.SetScrollInfo(co.SB_TYPE_CTL, &si, true)
+	return me
+}
+
+// Sets the current position of the scroll box with [win.HWND.SetScrollInfo].
+//
+// Returns the same object, so further operations can be chained.
+func (me *ScrollBar) SetPos(pos int) *ScrollBar {
+	var si win.SCROLLINFO
+	si.SetCbSize()
+	si.FMask = co.SIF_POS
+	si.NPos = int32(pos)
+	me.hWnd.SetScrollInfo(co.SB_TYPE_CTL, &si, true)
+	return me
+}
+
+// Sets the minimum and maximum scrolling positions with
+// [win.HWND.SetScrollInfo].
+//
+// Returns the same object, so further operations can be chained.
+func (me *ScrollBar) SetRange(min, max int) *ScrollBar {
+	var si win.SCROLLINFO
+	si.SetCbSize()
+	si.FMask = co.SIF_RANGE
+	si.NMin = int32(min)
+	si.NMax = int32(max)
+	me.hWnd.SetScrollInfo(co.SB_TYPE_CTL, &si, true)
+	return me
+}
+
+// Options for [NewScrollBar]; returned by [OptsScrollBar].
+type VarOptsScrollBar struct {
+	ctrlId     uint16
+	layout     LAY
+	position   win.POINT
+	size       win.SIZE
+	ctrlStyle  co.SBS
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+	rangeMin   int
+	rangeMax   int
+	pageSize   uint
+	lineSize   int
+}
+
+// Options for [NewScrollBar].
+func OptsScrollBar() *VarOptsScrollBar {
+	return &VarOptsScrollBar{
+		size:      win.SIZE{Cx: int32(DpiX(120)), Cy: int32(DpiY(17))},
+		ctrlStyle: co.SBS_HORZ,
+		wndStyle:  co.WS_CHILD | co.WS_VISIBLE,
+		rangeMax:  100,
+		pageSize:  10,
+		lineSize:  1,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsScrollBar) CtrlId(id uint16) *VarOptsScrollBar { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsScrollBar) Layout(l LAY) *VarOptsScrollBar { o.layout = l; return o }
+
+// Position coordinates within parent window client area, in pixels, passed to
+// [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsScrollBar) Position(x, y int) *VarOptsScrollBar {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control width in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.DpiX(120).
+func (o *VarOptsScrollBar) Width(w int) *VarOptsScrollBar { o.size.Cx = int32(w); return o }
+
+// Control height in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.DpiY(17).
+func (o *VarOptsScrollBar) Height(h int) *VarOptsScrollBar { o.size.Cy = int32(h); return o }
+
+// Scroll bar control [style], passed to [win.CreateWindowEx].
+//
+// Defaults to co.SBS_HORZ.
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/scroll-bar-control-styles
+func (o *VarOptsScrollBar) CtrlStyle(s co.SBS) *VarOptsScrollBar { o.ctrlStyle = s; return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE.
+func (o *VarOptsScrollBar) WndStyle(s co.WS) *VarOptsScrollBar { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT.
+func (o *VarOptsScrollBar) WndExStyle(s co.WS_EX) *VarOptsScrollBar { o.wndExStyle = s; return o }
+
+// Minimum and maximum scrolling positions.
+//
+// Defaults to 0 and 100.
+func (o *VarOptsScrollBar) Range(min, max int) *VarOptsScrollBar {
+	o.rangeMin = min
+	o.rangeMax = max
+	return o
+}
+
+// Page size, used to compute the proportional size of the scroll box.
+//
+// Defaults to 10.
+func (o *VarOptsScrollBar) PageSize(p uint) *VarOptsScrollBar { o.pageSize = p; return o }
+
+// Amount scrolled when the user clicks the arrows.
+//
+// Defaults to 1.
+func (o *VarOptsScrollBar) LineSize(l int) *VarOptsScrollBar { o.lineSize = l; return o }
+
+// Native [scroll bar] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [scroll bar]: https://learn.microsoft.com/en-us/windows/win32/controls/scroll-bars
+type EventsScrollBar struct {
+	hScroll []func(p WmScroll)
+	vScroll []func(p WmScroll)
+}
+
+// [WM_HSCROLL] message handler, sent by a horizontal scroll bar. Unlike the
+// parent window handler, it's called only for this scroll bar, and after the
+// scroll box position has been updated.
+//
+// Many handlers can be added; they're called in the order they were added.
+//
+// [WM_HSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-hscroll
+func (me *EventsScrollBar) WmHScroll(fun func(p WmScroll)) {
+	me.hScroll = append(me.hScroll, fun)
+}
+
+// [WM_VSCROLL] message handler, sent by a vertical scroll bar. Unlike the
+// parent window handler, it's called only for this scroll bar, and after the
+// scroll box position has been updated.
+//
+// Many handlers can be added; they're called in the order they were added.
+//
+// [WM_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-vscroll
+func (me *EventsScrollBar) WmVScroll(fun func(p WmScroll)) {
+	me.vScroll = append(me.vScroll, fun)
+}
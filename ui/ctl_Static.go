@@ -26,7 +26,7 @@ func NewStatic(parent Parent, opts *VarOptsStatic) *Static {
 
 	parent.base().beforeUserEvents.WmCreate(func(_ WmCreate) int {
 		if opts.size.Cx == 0 && opts.size.Cy == 0 {
-			opts.size, _ = calcTextBoundBox(utl.RemoveAccelAmpersands(opts.text))
+			opts.size, _ = calcTextBoundBox(win.HWND(0), utl.RemoveAccelAmpersands(opts.text))
 		}
 		me.createWindow(opts.wndExStyle, "STATIC", opts.text,
 			opts.wndStyle|co.WS(opts.ctrlStyle), opts.position, opts.size, parent, true)
@@ -65,7 +65,7 @@ func (me *Static) On() *EventsStatic {
 // Calls [win.HWND.SetWindowText] and resizes the control to exactly fit it.
 func (me *Static) SetTextAndResize(text string) *Static {
 	me.hWnd.SetWindowText(text)
-	boundBox, _ := calcTextBoundBox(utl.RemoveAccelAmpersands(text))
+	boundBox, _ := calcTextBoundBox(me.hWnd, utl.RemoveAccelAmpersands(text))
 	me.hWnd.SetWindowPos(win.HWND(0), 0, 0,
 		uint(boundBox.Cx), uint(boundBox.Cy), co.SWP_NOZORDER|co.SWP_NOMOVE)
 	return me
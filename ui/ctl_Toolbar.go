@@ -45,6 +45,14 @@ func NewToolbar(parent Parent, opts *VarOptsToolbar) *Toolbar {
 }
 
 func (me *Toolbar) defaultMessageHandlers(parent Parent) {
+	parent.base().onDpiChanged(func(oldDpi, newDpi int) {
+		if me.hWnd != 0 {
+			rescaleCtrlImageList(me.hWnd, co.TB_GETIMAGELIST, co.TB_SETIMAGELIST,
+				0, oldDpi, newDpi)
+			me.hWnd.SendMessage(co.TB_AUTOSIZE, 0, 0)
+		}
+	})
+
 	parent.base().afterUserEvents.WmDestroy(func() {
 		h, _ := me.hWnd.SendMessage(co.TB_GETIMAGELIST, 0, 0)
 		if h != 0 {
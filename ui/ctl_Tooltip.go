@@ -0,0 +1,322 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Native [tooltip] control.
+//
+// The tooltip is a popup window owned by the parent window; tools can be
+// registered for any child control, or for a rectangle within the parent
+// client area.
+//
+// [tooltip]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-controls
+type Tooltip struct {
+	hWnd       win.HWND
+	parent     Parent
+	tracking   bool
+	tools      map[uintptr]*_TooltipToolData // keyed by TooltipTool id
+	nextToolId uintptr
+	dispBuf    []uint16 // text returned in TTN_GETDISPINFO, must outlive the notification
+}
+
+type _TooltipToolData struct {
+	ctrl       ChildControl // nil for rectangle tools
+	rc         win.RECT
+	text       string
+	textFunc   func() string // if set, text is retrieved with TTN_GETDISPINFO
+	registered bool
+}
+
+// Creates a new [Tooltip] with [win.CreateWindowEx].
+//
+// Tools can be added before or after the parent window is created.
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//	var btn *ui.Button     // initialized somewhere
+//
+//	tip := ui.NewTooltip(
+//		wndOwner,
+//		ui.OptsTooltip().
+//			CtrlStyle(co.TTS_ALWAYSTIP | co.TTS_NOPREFIX | co.TTS_BALLOON),
+//	)
+//	tip.AddTool(btn, "Click to save the file.")
+func NewTooltip(parent Parent, opts *VarOptsTooltip) *Tooltip {
+	me := &Tooltip{
+		parent:   parent,
+		tracking: opts.tracking,
+		tools:    make(map[uintptr]*_TooltipToolData),
+	}
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		hInst, _ := parent.Hwnd().HInstance()
+		me.hWnd, _ = win.CreateWindowEx(opts.wndExStyle, win.ClassNameStr("tooltips_class32"),
+			"", co.WS_POPUP|co.WS(opts.ctrlStyle), 0, 0, 0, 0,
+			parent.Hwnd(), win.HMENU(0), hInst, win.LPARAM(0))
+		me.hWnd.SendMessage(co.WM_SETFONT, win.WPARAM(globalUiFont), win.LPARAM(1))
+		me.SetMaxWidth(opts.maxWidth)
+		if opts.title != "" {
+			me.SetTitle(opts.titleIcon, opts.title)
+		}
+		return 0 // ignored
+	})
+
+	parent.base().afterUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		for id, data := range me.tools { // all child controls are now created
+			if !data.registered {
+				me.registerTool(id, data)
+			}
+		}
+		return 0 // ignored
+	})
+
+	me.defaultMessageHandlers()
+	return me
+}
+
+func (me *Tooltip) defaultMessageHandlers() {
+	me.parent.base().beforeUserEvents.Wm(co.WM_NOTIFY, func(p Wm) uintptr {
+		pHdr := (*win.NMHDR)(unsafe.Pointer(p.LParam))
+		if me.hWnd == 0 || pHdr.HWndFrom != me.hWnd || co.NM(pHdr.Code) != co.TTN_GETDISPINFO {
+			return 0 // not for us
+		}
+
+		di := (*win.NMTTDISPINFO)(unsafe.Pointer(p.LParam))
+		for id, data := range me.tools {
+			if data.textFunc != nil && me.toolUid(id, data) == pHdr.IdFrom {
+				me.dispBuf = wstr.EncodeToSlice(data.textFunc())
+				di.LpszText = &me.dispBuf[0]
+				break
+			}
+		}
+		return 0 // ignored
+	})
+
+	me.parent.base().afterUserEvents.WmDestroy(func() {
+		me.tools = make(map[uintptr]*_TooltipToolData) // release closures
+		me.dispBuf = nil
+	})
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Note that this handle is initially zero, existing only after window creation.
+func (me *Tooltip) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Activates or deactivates the tooltip with [TTM_ACTIVATE].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [TTM_ACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-activate
+func (me *Tooltip) Activate(active bool) *Tooltip {
+	me.hWnd.SendMessage(co.TTM_ACTIVATE, win.WPARAM(utl.BoolToUintptr(active)), 0)
+	return me
+}
+
+// Registers a tool which shows the tooltip when the mouse hovers the given
+// child control.
+//
+// If the text contains line breaks, the tooltip will be displayed in multiple
+// lines, as long as a maximum width is set.
+//
+// Panics on error.
+func (me *Tooltip) AddTool(ctrl ChildControl, text string) TooltipTool {
+	return me.addTool(&_TooltipToolData{ctrl: ctrl, text: text})
+}
+
+// Registers a tool which shows the tooltip when the mouse hovers the given
+// rectangle, in parent client coordinates.
+//
+// Panics on error.
+func (me *Tooltip) AddRectTool(rc win.RECT, text string) TooltipTool {
+	return me.addTool(&_TooltipToolData{rc: rc, text: text})
+}
+
+func (me *Tooltip) addTool(data *_TooltipToolData) TooltipTool {
+	me.nextToolId++
+	id := me.nextToolId
+	me.tools[id] = data
+	if me.hWnd != 0 && (data.ctrl == nil || data.ctrl.Hwnd() != 0) {
+		me.registerTool(id, data)
+	} // otherwise it will be registered right after the parent is created
+	return TooltipTool{me, id}
+}
+
+func (me *Tooltip) registerTool(id uintptr, data *_TooltipToolData) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	ti := me.toolInfo(id, data)
+	if data.ctrl == nil {
+		ti.Rect = data.rc
+	}
+	if data.textFunc == nil {
+		ti.SetLpszText((*uint16)(wbuf.PtrAllowEmpty(data.text)))
+	} else {
+		ti.SetLpszTextCallback()
+	}
+
+	ret, _ := me.hWnd.SendMessage(co.TTM_ADDTOOL, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	if ret == 0 {
+		panic("TTM_ADDTOOL failed.")
+	}
+	data.registered = true
+}
+
+// Returns a TTTOOLINFO with the fields which identify the tool.
+func (me *Tooltip) toolInfo(id uintptr, data *_TooltipToolData) win.TTTOOLINFO {
+	var ti win.TTTOOLINFO
+	ti.SetCbSize()
+	ti.Hwnd = me.parent.Hwnd()
+	ti.UId = me.toolUid(id, data)
+
+	if data.ctrl != nil {
+		ti.UFlags = co.TTF_IDISHWND
+	}
+	if me.tracking {
+		ti.UFlags |= co.TTF_TRACK | co.TTF_ABSOLUTE
+	} else {
+		ti.UFlags |= co.TTF_SUBCLASS
+	}
+	return ti
+}
+
+// For child control tools the UID is the control HWND; otherwise, the id.
+func (me *Tooltip) toolUid(id uintptr, data *_TooltipToolData) uintptr {
+	if data.ctrl != nil {
+		return uintptr(data.ctrl.Hwnd())
+	}
+	return id
+}
+
+// Hides the tooltip, if visible, with [TTM_POP].
+//
+// [TTM_POP]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-pop
+func (me *Tooltip) Pop() {
+	me.hWnd.SendMessage(co.TTM_POP, 0, 0)
+}
+
+// Sets the initial, pop-up or reshow duration, in milliseconds, with
+// [TTM_SETDELAYTIME]. If milliseconds is -1, the default value is restored.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [TTM_SETDELAYTIME]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setdelaytime
+func (me *Tooltip) SetDelayTime(which co.TTDT, milliseconds int) *Tooltip {
+	me.hWnd.SendMessage(co.TTM_SETDELAYTIME,
+		win.WPARAM(which), win.LPARAM(milliseconds))
+	return me
+}
+
+// Sets the maximum width, in pixels, with [TTM_SETMAXTIPWIDTH]. Texts wider
+// than this are broken into multiple lines, and line breaks are respected. If
+// width is -1, any width is allowed.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [TTM_SETMAXTIPWIDTH]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setmaxtipwidth
+func (me *Tooltip) SetMaxWidth(width int) *Tooltip {
+	me.hWnd.SendMessage(co.TTM_SETMAXTIPWIDTH, 0, win.LPARAM(width))
+	return me
+}
+
+// Sets the title and the icon displayed along the text, with [TTM_SETTITLE].
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics on error.
+//
+// [TTM_SETTITLE]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-settitle
+func (me *Tooltip) SetTitle(icon co.TTI, title string) *Tooltip {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	ret, _ := me.hWnd.SendMessage(co.TTM_SETTITLE,
+		win.WPARAM(icon), win.LPARAM(wbuf.PtrAllowEmpty(title)))
+	if ret == 0 {
+		panic("TTM_SETTITLE failed.")
+	}
+	return me
+}
+
+// Sets the position of a tracking tooltip, in screen coordinates, with
+// [TTM_TRACKPOSITION].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [TTM_TRACKPOSITION]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-trackposition
+func (me *Tooltip) TrackPosition(x, y int) *Tooltip {
+	me.hWnd.SendMessage(co.TTM_TRACKPOSITION,
+		0, win.LPARAM(win.MAKELONG(uint16(x), uint16(y))))
+	return me
+}
+
+// Options for [NewTooltip]; returned by [OptsTooltip].
+type VarOptsTooltip struct {
+	ctrlStyle  co.TTS
+	wndExStyle co.WS_EX
+	maxWidth   int
+	titleIcon  co.TTI
+	title      string
+	tracking   bool
+}
+
+// Options for [NewTooltip].
+func OptsTooltip() *VarOptsTooltip {
+	return &VarOptsTooltip{
+		ctrlStyle:  co.TTS_ALWAYSTIP | co.TTS_NOPREFIX,
+		wndExStyle: co.WS_EX_TOPMOST,
+		maxWidth:   DpiX(300),
+	}
+}
+
+// Tooltip control [style], passed to [win.CreateWindowEx].
+//
+// Defaults to co.TTS_ALWAYSTIP | co.TTS_NOPREFIX.
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-styles
+func (o *VarOptsTooltip) CtrlStyle(s co.TTS) *VarOptsTooltip { o.ctrlStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_TOPMOST.
+func (o *VarOptsTooltip) WndExStyle(s co.WS_EX) *VarOptsTooltip { o.wndExStyle = s; return o }
+
+// Maximum width in pixels, set with [TTM_SETMAXTIPWIDTH]. Wider texts are
+// displayed in multiple lines. Set -1 to allow any width.
+//
+// Defaults to ui.DpiX(300).
+//
+// [TTM_SETMAXTIPWIDTH]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setmaxtipwidth
+func (o *VarOptsTooltip) MaxWidth(w int) *VarOptsTooltip { o.maxWidth = w; return o }
+
+// Title and icon displayed along the text, set with [TTM_SETTITLE].
+//
+// Defaults to none.
+//
+// [TTM_SETTITLE]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-settitle
+func (o *VarOptsTooltip) Title(icon co.TTI, title string) *VarOptsTooltip {
+	o.titleIcon = icon
+	o.title = title
+	return o
+}
+
+// Creates a [tracking tooltip], whose tools are displayed only with
+// [TooltipTool.TrackActivate], at the position given by
+// [Tooltip.TrackPosition].
+//
+// Defaults to false.
+//
+// [tracking tooltip]: https://learn.microsoft.com/en-us/windows/win32/controls/implement-tracking-tooltips
+func (o *VarOptsTooltip) Tracking(t bool) *VarOptsTooltip { o.tracking = t; return o }
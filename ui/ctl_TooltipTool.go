@@ -0,0 +1,128 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// A tool registered in a [tooltip].
+//
+// [tooltip]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-controls
+type TooltipTool struct {
+	owner *Tooltip
+	id    uintptr
+}
+
+func (me TooltipTool) data() *_TooltipToolData {
+	if data, ok := me.owner.tools[me.id]; ok {
+		return data
+	}
+	panic("Tooltip tool was removed.")
+}
+
+// Removes the tool with [TTM_DELTOOL].
+//
+// [TTM_DELTOOL]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-deltool
+func (me TooltipTool) Remove() {
+	data := me.data()
+	if data.registered {
+		ti := me.owner.toolInfo(me.id, data)
+		me.owner.hWnd.SendMessage(co.TTM_DELTOOL, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	}
+	delete(me.owner.tools, me.id)
+}
+
+// Sets the bounding rectangle of a rectangle tool, in parent client
+// coordinates, with [TTM_NEWTOOLRECT].
+//
+// Panics if the tool was registered for a child control.
+//
+// [TTM_NEWTOOLRECT]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-newtoolrect
+func (me TooltipTool) SetRect(rc win.RECT) TooltipTool {
+	data := me.data()
+	if data.ctrl != nil {
+		panic("Cannot set the rectangle of a child control tool.")
+	}
+
+	data.rc = rc
+	if data.registered {
+		ti := me.owner.toolInfo(me.id, data)
+		ti.Rect = rc
+		me.owner.hWnd.SendMessage(co.TTM_NEWTOOLRECT, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	}
+	return me
+}
+
+// Sets the text of the tool with [TTM_UPDATETIPTEXT].
+//
+// Returns the same tool, so further operations can be chained.
+//
+// [TTM_UPDATETIPTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-updatetiptext
+func (me TooltipTool) SetText(text string) TooltipTool {
+	data := me.data()
+	data.text = text
+	data.textFunc = nil
+	if data.registered {
+		wbuf := wstr.NewBufEncoder()
+		defer wbuf.Free()
+
+		ti := me.owner.toolInfo(me.id, data)
+		ti.SetLpszText((*uint16)(wbuf.PtrAllowEmpty(text)))
+		me.owner.hWnd.SendMessage(co.TTM_UPDATETIPTEXT, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	}
+	return me
+}
+
+// Sets a callback to retrieve the text of the tool each time the tooltip is
+// about to be displayed, by handling [TTN_GETDISPINFO].
+//
+// Returns the same tool, so further operations can be chained.
+//
+// # Example
+//
+//	var tip *ui.Tooltip // initialized somewhere
+//	var btn *ui.Button  // initialized somewhere
+//
+//	tip.AddTool(btn, "").
+//		SetTextFunc(func() string {
+//			return time.Now().Format(time.TimeOnly)
+//		})
+//
+// [TTN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-getdispinfo
+func (me TooltipTool) SetTextFunc(fun func() string) TooltipTool {
+	data := me.data()
+	data.textFunc = fun
+	if data.registered {
+		ti := me.owner.toolInfo(me.id, data)
+		ti.SetLpszTextCallback()
+		me.owner.hWnd.SendMessage(co.TTM_UPDATETIPTEXT, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	}
+	return me
+}
+
+// Shows or hides a tracking tooltip for this tool with [TTM_TRACKACTIVATE].
+// The tooltip must have been created with [VarOptsTooltip.Tracking].
+//
+// Returns the same tool, so further operations can be chained.
+//
+// # Example
+//
+//	var tip *ui.Tooltip // initialized somewhere
+//	var tool ui.TooltipTool // initialized somewhere
+//
+//	tip.TrackPosition(300, 200)
+//	tool.TrackActivate(true)
+//
+// [TTM_TRACKACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-trackactivate
+func (me TooltipTool) TrackActivate(show bool) TooltipTool {
+	ti := me.owner.toolInfo(me.id, me.data())
+	me.owner.hWnd.SendMessage(co.TTM_TRACKACTIVATE,
+		win.WPARAM(utl.BoolToUintptr(show)), win.LPARAM(unsafe.Pointer(&ti)))
+	return me
+}
@@ -3,11 +3,13 @@
 package ui
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/internal/utl"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
 )
 
 // Native [tree view] control.
@@ -15,9 +17,18 @@ import (
 // [tree view]: https://learn.microsoft.com/en-us/windows/win32/controls/tree-view-controls
 type TreeView struct {
 	_BaseCtrl
-	events    EventsTreeView
-	itemsData map[win.HTREEITEM]interface{} // data associated with each item; replaces LPARAM approach
-	Items     CollectionTreeViewItems       // Methods to interact with the items collection.
+	events           EventsTreeView
+	itemsData        map[win.HTREEITEM]interface{} // data associated with each item; replaces LPARAM approach
+	childrenLoader   func(parent TreeViewItem)
+	childrenQuery    func(item TreeViewItem) bool
+	discardCollapsed bool
+	itemDrag         func(source, target TreeViewItem, isCopy bool) bool
+	dragEvents       *EventsWindow // parent events, where SetItemDrag installs the drag handlers
+	dragOn           bool          // drag handlers installed by SetItemDrag
+	drag             _ItemDrag
+	dragSrc          win.HTREEITEM
+	dragTarget       win.HTREEITEM           // zero if the cursor is not over a valid target
+	Items            CollectionTreeViewItems // Methods to interact with the items collection.
 }
 
 // Creates a new [TreeView] with [win.CreateWindowEx].
@@ -26,6 +37,7 @@ func NewTreeView(parent Parent, opts *VarOptsTreeView) *TreeView {
 	me := &TreeView{
 		_BaseCtrl: newBaseCtrl(opts.ctrlId),
 		events:    EventsTreeView{opts.ctrlId, &parent.base().userEvents},
+		itemsData: make(map[win.HTREEITEM]interface{}),
 	}
 	me.Items.owner = me
 
@@ -49,6 +61,7 @@ func NewTreeViewDlg(parent Parent, ctrlId uint16, layout LAY) *TreeView {
 	me := &TreeView{
 		_BaseCtrl: newBaseCtrl(ctrlId),
 		events:    EventsTreeView{ctrlId, &parent.base().userEvents},
+		itemsData: make(map[win.HTREEITEM]interface{}),
 	}
 	me.Items.owner = me
 
@@ -63,12 +76,65 @@ func NewTreeViewDlg(parent Parent, ctrlId uint16, layout LAY) *TreeView {
 }
 
 func (me *TreeView) defaultMessageHandlers(parent Parent) {
+	me.dragEvents = &parent.base().beforeUserEvents
+
 	parent.base().afterUserEvents.WmNotify(me.ctrlId, co.TVN_DELETEITEM, func(p unsafe.Pointer) uintptr {
 		nmtv := (*win.NMTREEVIEW)(p)
 		delete(me.itemsData, nmtv.ItemOld.HItem)
 		return 0 // ignored
 	})
 
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.TVN_ITEMEXPANDING, func(p unsafe.Pointer) uintptr {
+		nmtv := (*win.NMTREEVIEW)(p)
+		if me.childrenLoader != nil && co.TVE(nmtv.Action) == co.TVE_EXPAND {
+			item := me.Items.Get(nmtv.ItemNew.HItem)
+			if _, hasChild := item.FirstChild(); !hasChild {
+				me.childrenLoader(item)
+				if _, hasChild := item.FirstChild(); !hasChild {
+					item.SetHasChildren(co.TVI_CHILDREN_ZERO) // nothing loaded, remove the expand button
+				}
+			}
+		}
+		return 0 // ignored
+	})
+
+	parent.base().afterUserEvents.WmNotify(me.ctrlId, co.TVN_ITEMEXPANDED, func(p unsafe.Pointer) uintptr {
+		nmtv := (*win.NMTREEVIEW)(p)
+		if me.childrenLoader != nil && me.discardCollapsed && co.TVE(nmtv.Action) == co.TVE_COLLAPSE {
+			item := me.Items.Get(nmtv.ItemNew.HItem)
+			for _, child := range item.Children() {
+				child.Delete() // data will be released on TVN_DELETEITEM
+			}
+			item.SetHasChildren(co.TVI_CHILDREN_ONE) // keep the expand button, so children can be loaded again
+			item.setState(co.TVIS_EXPANDEDONCE, false)
+		}
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.TVN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
+		nmtv := (*win.NMTVDISPINFO)(p)
+		if (nmtv.Item.Mask & co.TVIF_CHILDREN) != 0 {
+			hasChildren := true // if unknown, assume it has children, which will be loaded on expansion
+			if me.childrenQuery != nil {
+				hasChildren = me.childrenQuery(me.Items.Get(nmtv.Item.HItem))
+			}
+			if hasChildren {
+				nmtv.Item.CChildren = co.TVI_CHILDREN_ONE
+			} else {
+				nmtv.Item.CChildren = co.TVI_CHILDREN_ZERO
+			}
+		}
+		return 0 // ignored
+	})
+
+	parent.base().onDpiChanged(func(oldDpi, newDpi int) {
+		kinds := []co.TVSIL{co.TVSIL_NORMAL, co.TVSIL_STATE}
+		for _, kind := range kinds {
+			rescaleCtrlImageList(me.hWnd, co.TVM_GETIMAGELIST, co.TVM_SETIMAGELIST,
+				win.WPARAM(kind), oldDpi, newDpi)
+		}
+	})
+
 	parent.base().afterUserEvents.WmDestroy(func() {
 		kinds := []co.TVSIL{co.TVSIL_NORMAL, co.TVSIL_STATE}
 		for _, kind := range kinds {
@@ -81,6 +147,179 @@ func (me *TreeView) defaultMessageHandlers(parent Parent) {
 	})
 }
 
+// Installs the handlers of the item dragging. Since the parent window doesn't
+// pass the mouse messages to DefWindowProc when they're handled, this is done
+// only when SetItemDrag is called.
+func (me *TreeView) itemDragMessageHandlers() {
+	me.dragEvents.WmNotify(me.ctrlId, co.TVN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
+		if me.itemDrag != nil {
+			me.beginItemDrag((*win.NMTREEVIEW)(p))
+		}
+		return 0 // ignored
+	})
+
+	me.dragEvents.WmNotify(me.ctrlId, co.TVN_KEYDOWN, func(p unsafe.Pointer) uintptr {
+		if me.drag.active && (*win.NMTVKEYDOWN)(p).WVKey == co.VK_ESCAPE {
+			me.endItemDrag()
+		}
+		return 0 // ignored
+	})
+
+	// While dragging, the mouse is captured by the parent window.
+	me.dragEvents.WmMouseMove(func(_ WmMouse) {
+		if me.drag.active {
+			me.updateItemDrag()
+		}
+	})
+	me.dragEvents.WmLButtonUp(func(p WmMouse) {
+		if me.drag.active {
+			me.dropItemDrag(p.HasCtrl())
+		}
+	})
+	me.dragEvents.WmCaptureChanged(func(_ WmCaptureChanged) {
+		if me.drag.active { // capture taken by someone else
+			me.endItemDrag()
+		}
+	})
+	me.dragEvents.WmTimer(itemDragTimerId(me.ctrlId), func() {
+		if me.drag.active {
+			me.drag.scrollStep()
+			me.updateItemDrag()
+		}
+	})
+}
+
+// Starts dragging an item, on TVN_BEGINDRAG.
+func (me *TreeView) beginItemDrag(nmtv *win.NMTREEVIEW) {
+	source := me.Items.Get(nmtv.ItemNew.HItem)
+
+	hImgRet, _ := me.hWnd.SendMessage(co.TVM_CREATEDRAGIMAGE, 0, win.LPARAM(source.hItem))
+	hImg := win.HIMAGELIST(hImgRet) // zero if the control has no image list
+
+	var hotspot win.POINT
+	if hImg != win.HIMAGELIST(0) {
+		rcText := source.ItemRect(true)
+		szImg, _ := hImg.GetIconSize() // icon followed by the text
+		hotspot.X = nmtv.PtDrag.X - rcText.Left + szImg.Cx - (rcText.Right - rcText.Left)
+		hotspot.Y = nmtv.PtDrag.Y - rcText.Top
+	}
+
+	me.dragSrc = source.hItem
+	me.dragTarget = win.HTREEITEM(0)
+	hParent, _ := me.hWnd.GetAncestor(co.GA_PARENT)
+	me.drag.begin(me.hWnd, hParent, hImg, hotspot, itemDragTimerId(me.ctrlId))
+	me.updateItemDrag()
+}
+
+// Highlights the item under the cursor, if it can receive the dragged item.
+func (me *TreeView) updateItemDrag() {
+	pt := me.drag.cursorPos()
+	tvhti := win.TVHITTESTINFO{
+		Pt: pt,
+	}
+	me.hWnd.SendMessage(co.TVM_HITTEST, 0, win.LPARAM(unsafe.Pointer(&tvhti)))
+
+	hTarget := tvhti.HItem
+	for hItem := hTarget; hItem != win.HTREEITEM(0); { // can't drop into itself or its descendants
+		if hItem == me.dragSrc {
+			hTarget = win.HTREEITEM(0)
+			break
+		}
+		hParent, _ := me.hWnd.SendMessage(co.TVM_GETNEXTITEM,
+			win.WPARAM(co.TVGN_PARENT), win.LPARAM(hItem))
+		hItem = win.HTREEITEM(hParent)
+	}
+
+	if hTarget != me.dragTarget {
+		me.drag.showImage(false)
+		me.hWnd.SendMessage(co.TVM_SELECTITEM,
+			win.WPARAM(co.TVGN_DROPHILITE), win.LPARAM(hTarget))
+		me.hWnd.UpdateWindow()
+		me.drag.showImage(true)
+		me.dragTarget = hTarget
+	}
+
+	me.drag.moveImage(hTarget != win.HTREEITEM(0))
+	me.drag.updateAutoScroll(pt)
+}
+
+// Finishes the drag operation when the mouse button is released, moving or
+// copying the item if the callback allows it.
+func (me *TreeView) dropItemDrag(isCopy bool) {
+	hSource, hTarget := me.dragSrc, me.dragTarget
+	me.endItemDrag()
+	if hTarget == win.HTREEITEM(0) {
+		return
+	}
+
+	source, target := me.Items.Get(hSource), me.Items.Get(hTarget)
+	if !me.itemDrag(source, target, isCopy) {
+		return
+	}
+
+	if !target.IsExpanded() {
+		target.Expand(true) // so children loaded on demand come before the new item
+	}
+	newItem := me.copyItem(source, target)
+	target.SetHasChildren(co.TVI_CHILDREN_ONE)
+	if !isCopy {
+		source.Delete()
+	}
+	target.Expand(true)
+	me.hWnd.SendMessage(co.TVM_SELECTITEM,
+		win.WPARAM(co.TVGN_CARET), win.LPARAM(newItem.hItem))
+	newItem.EnsureVisible()
+}
+
+// Cancels or finishes the drag operation, removing the drop highlight.
+func (me *TreeView) endItemDrag() {
+	me.drag.end()
+	me.hWnd.SendMessage(co.TVM_SELECTITEM, win.WPARAM(co.TVGN_DROPHILITE), 0)
+	me.dragSrc = win.HTREEITEM(0)
+	me.dragTarget = win.HTREEITEM(0)
+}
+
+// Recursively copies the item and its children – text, icons, data and
+// expansion – as the last child of newParent, returning the new item.
+func (me *TreeView) copyItem(source, newParent TreeViewItem) TreeViewItem {
+	recvBuf := wstr.NewBufDecoder(wstr.BUF_MAX)
+	defer recvBuf.Free()
+
+	tvi := win.TVITEMEX{
+		HItem: source.hItem,
+		Mask:  co.TVIF_TEXT | co.TVIF_IMAGE | co.TVIF_SELECTEDIMAGE | co.TVIF_CHILDREN,
+	}
+	tvi.SetPszText(recvBuf.HotSlice())
+	if ret, _ := me.hWnd.SendMessage(co.TVM_GETITEM, 0, win.LPARAM(unsafe.Pointer(&tvi))); ret == 0 {
+		panic("TVM_GETITEM failed.")
+	}
+
+	tvis := win.TVINSERTSTRUCT{
+		HParent:      newParent.hItem,
+		HInsertAfter: win.HTREEITEM_LAST,
+		Itemex:       tvi,
+	}
+	tvis.Itemex.HItem = win.HTREEITEM(0)
+
+	hItemRet, _ := me.hWnd.SendMessage(co.TVM_INSERTITEM,
+		0, win.LPARAM(unsafe.Pointer(&tvis)))
+	if hItemRet == 0 {
+		panic(fmt.Sprintf("TVM_INSERTITEM \"%s\" failed.", recvBuf.String()))
+	}
+	newItem := me.Items.Get(win.HTREEITEM(hItemRet))
+
+	if data, ok := me.itemsData[source.hItem]; ok {
+		me.itemsData[newItem.hItem] = data
+	}
+	for _, child := range source.Children() {
+		me.copyItem(child, newItem)
+	}
+	if source.IsExpanded() {
+		newItem.Expand(true)
+	}
+	return newItem
+}
+
 // Exposes all the control notifications the can be handled.
 //
 // Panics if called after the control has been created.
@@ -107,6 +346,59 @@ func (me *TreeView) ImageList(which co.TVSIL) win.HIMAGELIST {
 	return hImg
 }
 
+// Sets the callback which populates the children of an item on demand, when
+// the item is expanded for the first time, on [TVN_ITEMEXPANDING]. If the
+// callback adds no children, the expand button of the item is removed.
+//
+// Items which have children to be loaded must be marked with
+// [TreeViewItem.SetHasChildren], so the expand button is displayed.
+//
+// If discardOnCollapse is true, the children are deleted when the item is
+// collapsed – releasing their data –, and will be loaded again when the item
+// is expanded.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var tv *ui.TreeView // initialized somewhere
+//
+//	tv.SetChildrenLoader(true, func(parent ui.TreeViewItem) {
+//		dir := parent.Data().(string)
+//		entries, _ := os.ReadDir(dir)
+//		for _, entry := range entries {
+//			if entry.IsDir() {
+//				child := parent.AddChild(entry.Name(), -1).
+//					SetHasChildren(co.TVI_CHILDREN_ONE)
+//				child.SetData(filepath.Join(dir, entry.Name()))
+//			}
+//		}
+//	})
+//
+// [TVN_ITEMEXPANDING]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-itemexpanding
+func (me *TreeView) SetChildrenLoader(
+	discardOnCollapse bool,
+	fun func(parent TreeViewItem),
+) *TreeView {
+	me.childrenLoader = fun
+	me.discardCollapsed = discardOnCollapse
+	return me
+}
+
+// Sets the callback which tells whether an item has children, for the items
+// marked with co.TVI_CHILDREN_CALLBACK in [TreeViewItem.SetHasChildren],
+// called on [TVN_GETDISPINFO].
+//
+// If not set, these items are assumed to have children.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [TVN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-getdispinfo
+func (me *TreeView) SetChildrenQuery(fun func(item TreeViewItem) bool) *TreeView {
+	me.childrenQuery = fun
+	return me
+}
+
 // Adds or removes extended styles with [TVM_SETEXTENDEDSTYLE].
 //
 // Returns the same object, so further operations can be chained.
@@ -122,6 +414,43 @@ func (me *TreeView) SetExtendedStyle(doSet bool, style co.TVS_EX) *TreeView {
 	return me
 }
 
+// Enables dragging the items with the mouse, to move them into another parent
+// item. If Ctrl is pressed when the item is dropped, it's copied instead. The
+// items can't be dragged if the control has the co.TVS_DISABLEDRAGDROP style.
+//
+// While dragging, a drag image is displayed, the item under the cursor is
+// highlighted, and the control is scrolled when the cursor gets close to its
+// top or bottom edges. Esc cancels the operation.
+//
+// When the item is dropped, the callback is called, receiving the dragged
+// item, the item which will become its parent, and whether it will be copied.
+// If the callback returns true, the item – with its children and data – is
+// moved or copied as the last child of target; if it returns false, nothing is
+// done. Passing nil disables the dragging.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var tv *ui.TreeView // initialized somewhere
+//
+//	tv.SetItemDrag(func(source, target ui.TreeViewItem, isCopy bool) bool {
+//		return target.Text() != "Read-only"
+//	})
+func (me *TreeView) SetItemDrag(
+	fun func(source, target TreeViewItem, isCopy bool) bool,
+) *TreeView {
+	if fun == nil && me.drag.active {
+		me.endItemDrag()
+	}
+	me.itemDrag = fun
+	if fun != nil && !me.dragOn {
+		me.dragOn = true
+		me.itemDragMessageHandlers()
+	}
+	return me
+}
+
 // Options for [NewTreeView]; returned by [OptsTreeView].
 type VarOptsTreeView struct {
 	ctrlId      uint16
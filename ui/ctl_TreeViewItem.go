@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"unsafe"
 
+	"github.com/rodrigocfd/windigo/internal/utl"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/wstr"
@@ -89,7 +90,7 @@ func (me TreeViewItem) Children() []TreeViewItem {
 //
 //	item.SetData(&Person{Name: "foo"})
 //
-//	if person := item.Data().(*Person); person != nil {
+//	if person, ok := item.Data().(*Person); ok {
 //		println(person.Name)
 //	}
 func (me TreeViewItem) Data() interface{} {
@@ -136,6 +137,18 @@ func (me TreeViewItem) Expand(doExpand bool) TreeViewItem {
 	return me
 }
 
+// Retrieves the first child item, if any, with [TVM_GETNEXTITEM].
+//
+// [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
+func (me TreeViewItem) FirstChild() (TreeViewItem, bool) {
+	hChild, _ := me.owner.hWnd.SendMessage(co.TVM_GETNEXTITEM,
+		win.WPARAM(co.TVGN_CHILD), win.LPARAM(me.hItem))
+	if hChild != 0 {
+		return TreeViewItem{me.owner, win.HTREEITEM(hChild)}, true
+	}
+	return TreeViewItem{}, false
+}
+
 // Returns the unique handle that identifies item.
 func (me TreeViewItem) Htreeitem() win.HTREEITEM {
 	return me.hItem
@@ -176,6 +189,25 @@ func (me TreeViewItem) IsRoot() bool {
 	return !hasParent
 }
 
+// Retrieves the bounding rectangle of the item with [TVM_GETITEMRECT],
+// relative to the tree view. If textOnly is true, the rectangle includes only
+// the text; otherwise, it includes the whole line.
+//
+// The item must be visible, otherwise the rectangle will be zero.
+//
+// [TVM_GETITEMRECT]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getitemrect
+func (me TreeViewItem) ItemRect(textOnly bool) win.RECT {
+	var rc win.RECT
+	*(*win.HTREEITEM)(unsafe.Pointer(&rc)) = me.hItem // HTREEITEM is passed in the RECT itself
+
+	ret, _ := me.owner.hWnd.SendMessage(co.TVM_GETITEMRECT,
+		win.WPARAM(utl.BoolToUintptr(textOnly)), win.LPARAM(unsafe.Pointer(&rc)))
+	if ret == 0 {
+		return win.RECT{}
+	}
+	return rc
+}
+
 // Retrieves the next sibling item, if any, with [TVM_GETNEXTITEM].
 //
 // [TVM_GETNEXTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-getnextitem
@@ -212,7 +244,8 @@ func (me TreeViewItem) PrevSibling() (TreeViewItem, bool) {
 	return TreeViewItem{}, false
 }
 
-// Stores user-custom data for this item.
+// Stores user-custom data for this item. The data is kept on the Go side, and
+// it's automatically released when the item is deleted.
 //
 // # Example
 //
@@ -224,13 +257,40 @@ func (me TreeViewItem) PrevSibling() (TreeViewItem, bool) {
 //
 //	item.SetData(&Person{Name: "foo"})
 //
-//	if person := item.Data().(*Person); person != nil {
+//	if person, ok := item.Data().(*Person); ok {
 //		println(person.Name)
 //	}
 func (me TreeViewItem) SetData(data interface{}) {
 	me.owner.itemsData[me.hItem] = data
 }
 
+// Sets whether the item displays the expand button, regardless of having
+// child items, with [TVM_SETITEM]. This is useful to load the children on
+// demand, with [TreeView.SetChildrenLoader].
+//
+// With co.TVI_CHILDREN_CALLBACK, the control will ask whether the item has
+// children through the callback set with [TreeView.SetChildrenQuery].
+//
+// Returns the same item, so further operations can be chained.
+//
+// Panics on error.
+//
+// [TVM_SETITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-setitem
+func (me TreeViewItem) SetHasChildren(children co.TVI_CHILDREN) TreeViewItem {
+	tvi := win.TVITEMEX{
+		HItem:     me.hItem,
+		Mask:      co.TVIF_CHILDREN,
+		CChildren: children,
+	}
+
+	ret, err := me.owner.hWnd.SendMessage(co.TVM_SETITEM,
+		0, win.LPARAM(unsafe.Pointer(&tvi)))
+	if ret == 0 || err != nil {
+		panic("TVM_SETITEM failed.")
+	}
+	return me
+}
+
 // Sets the zero-based icon index with [TVM_SETITEM].
 //
 // Returns the same item, so further operations can be chained.
@@ -300,3 +360,20 @@ func (me TreeViewItem) Text() string {
 	}
 	return recvBuf.String()
 }
+
+func (me TreeViewItem) setState(state co.TVIS, doSet bool) {
+	tvi := win.TVITEMEX{
+		HItem:     me.hItem,
+		Mask:      co.TVIF_STATE,
+		StateMask: state,
+	}
+	if doSet {
+		tvi.State = state
+	}
+
+	ret, err := me.owner.hWnd.SendMessage(co.TVM_SETITEM,
+		0, win.LPARAM(unsafe.Pointer(&tvi)))
+	if ret == 0 || err != nil {
+		panic("TVM_SETITEM failed.")
+	}
+}
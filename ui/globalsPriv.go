@@ -9,9 +9,16 @@ import (
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
-// Global UI font.
+// Global UI font, for the system DPI.
 var globalUiFont win.HFONT
 
+// Global UI fonts for DPIs other than the system one, created on demand.
+var (
+	globalUiFontsDpi   = make(map[int]win.HFONT)
+	globalUiFontLogfnt win.LOGFONT
+	globalUiFontDpi    int // DPI of globalUiFont
+)
+
 func createGlobalUiFont() error {
 	if globalUiFont == 0 {
 		var err error
@@ -31,10 +38,178 @@ func createGlobalUiFont() error {
 		if err != nil {
 			return err
 		}
+		globalUiFontLogfnt = ncm.LfMenuFont
+		globalUiFontDpi = systemDpi()
 	}
 	return nil
 }
 
+// Returns the global UI font scaled to the given DPI, creating it if needed.
+func uiFontForDpi(dpi int) win.HFONT {
+	if globalUiFont == 0 || dpi == globalUiFontDpi {
+		return globalUiFont
+	}
+	if hFont, ok := globalUiFontsDpi[dpi]; ok {
+		return hFont
+	}
+
+	lf := globalUiFontLogfnt
+	lf.LfHeight = scaleDpi(lf.LfHeight, globalUiFontDpi, dpi)
+	hFont, err := win.CreateFontIndirect(&lf)
+	if err != nil {
+		return globalUiFont
+	}
+	globalUiFontsDpi[dpi] = hFont
+	return hFont
+}
+
+// Deletes the global UI font, and all the fonts scaled to other DPIs.
+func deleteGlobalUiFonts() {
+	for dpi, hFont := range globalUiFontsDpi {
+		hFont.DeleteObject()
+		delete(globalUiFontsDpi, dpi)
+	}
+	if globalUiFont != 0 {
+		globalUiFont.DeleteObject()
+		globalUiFont = 0
+	}
+}
+
+// Set by RunAsMain() if the process is per-monitor DPI aware, so each window
+// has its own DPI.
+var globalPerMonitorDpi bool
+
+// Enables per-monitor DPI awareness, on Windows 10 version 1703 and later.
+// Otherwise, the whole process uses the system DPI.
+func setProcessDpiAwareness() error {
+	if isWindows10BuildOrGreater(15063) { // version 1703
+		if err := win.SetProcessDpiAwarenessContext(
+			co.DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2); err == nil {
+			globalPerMonitorDpi = true
+			return nil
+		}
+	}
+	if win.IsWindowsVistaOrGreater() {
+		return win.SetProcessDPIAware()
+	}
+	return nil
+}
+
+// Checks the Windows 10 build number. Returns false if the application has no
+// manifest declaring Windows 10 compatibility, since the version is reported
+// as Windows 8 in this case.
+func isWindows10BuildOrGreater(build uint32) bool {
+	ovi := win.OSVERSIONINFOEX{
+		DwMajorVersion: uint32(win.HIBYTE(uint16(co.WIN32_WINNT_WINTHRESHOLD))),
+		DwMinorVersion: uint32(win.LOBYTE(uint16(co.WIN32_WINNT_WINTHRESHOLD))),
+		DwBuildNumber:  build,
+	}
+	ovi.SetDwOsVersionInfoSize()
+
+	conditionMask := win.VerSetConditionMask(
+		win.VerSetConditionMask(
+			win.VerSetConditionMask(0, co.VER_MAJORVERSION, co.VER_COND_GREATER_EQUAL),
+			co.VER_MINORVERSION, co.VER_COND_GREATER_EQUAL),
+		co.VER_BUILDNUMBER, co.VER_COND_GREATER_EQUAL)
+
+	ret, _ := win.VerifyVersionInfo(&ovi,
+		co.VER_MAJORVERSION|co.VER_MINORVERSION|co.VER_BUILDNUMBER,
+		conditionMask)
+	return ret
+}
+
+// Returns the DPI of the window, or the system DPI if the process is not
+// per-monitor DPI aware.
+func windowDpi(hWnd win.HWND) int {
+	if globalPerMonitorDpi && hWnd != 0 {
+		if dpi := hWnd.GetDpiForWindow(); dpi != 0 {
+			return int(dpi)
+		}
+	}
+	return systemDpi()
+}
+
+// Returns the system DPI, which is the DPI of the primary monitor when the user
+// logged in. Positions and sizes given by the user are in this DPI.
+func systemDpi() int {
+	if globalPerMonitorDpi { // Windows 10 version 1703 and later
+		if dpi := win.GetDpiForSystem(); dpi != 0 {
+			return int(dpi)
+		}
+	}
+	hdcScreen, err := win.HWND(0).GetDC()
+	if err != nil {
+		return 96
+	}
+	defer win.HWND(0).ReleaseDC(hdcScreen)
+	return int(hdcScreen.GetDeviceCaps(co.GDC_LOGPIXELSY))
+}
+
+// Converts a value from one DPI to another, rounding to the nearest integer.
+func scaleDpi(v int32, oldDpi, newDpi int) int32 {
+	prod := int64(v) * int64(newDpi)
+	if prod < 0 {
+		return int32((prod - int64(oldDpi)/2) / int64(oldDpi))
+	}
+	return int32((prod + int64(oldDpi)/2) / int64(oldDpi))
+}
+
+// Converts a size from one DPI to another.
+func scaleSizeDpi(sz win.SIZE, oldDpi, newDpi int) win.SIZE {
+	return win.SIZE{Cx: scaleDpi(sz.Cx, oldDpi, newDpi), Cy: scaleDpi(sz.Cy, oldDpi, newDpi)}
+}
+
+// Converts a position and a size, given in system DPI, to the DPI of the
+// parent window, which can be in a monitor with a different DPI.
+func scaleToParentDpi(hParent win.HWND, pos win.POINT, size win.SIZE) (win.POINT, win.SIZE) {
+	sysDpi := systemDpi()
+	if dpi := windowDpi(hParent); dpi != sysDpi {
+		pos = win.POINT{X: scaleDpi(pos.X, sysDpi, dpi), Y: scaleDpi(pos.Y, sysDpi, dpi)}
+		size = scaleSizeDpi(size, sysDpi, dpi)
+	}
+	return pos, size
+}
+
+// Replaces an image list of the control with a copy whose images are scaled to
+// the new DPI, then destroys the old one. Does nothing if the control has no
+// such image list.
+func rescaleCtrlImageList(
+	hCtrl win.HWND,
+	msgGet, msgSet co.WM,
+	which win.WPARAM,
+	oldDpi, newDpi int,
+) {
+	if hCtrl == 0 {
+		return // control not created yet
+	}
+	h, _ := hCtrl.SendMessage(msgGet, which, 0)
+	hImg := win.HIMAGELIST(h)
+	if hImg == win.HIMAGELIST(0) {
+		return
+	}
+
+	sz, err := hImg.GetIconSize()
+	if err != nil {
+		return
+	}
+	hNewImg, err := win.ImageListCreate(
+		uint(scaleDpi(sz.Cx, oldDpi, newDpi)), uint(scaleDpi(sz.Cy, oldDpi, newDpi)),
+		co.ILC_COLOR32, hImg.GetImageCount(), 1)
+	if err != nil {
+		return
+	}
+
+	for i := 0; i < int(hImg.GetImageCount()); i++ {
+		if hIcon, err := hImg.GetIcon(i, co.ILD_NORMAL); err == nil {
+			hNewImg.AddIcon(hIcon) // stretched to the new size
+			hIcon.DestroyIcon()
+		}
+	}
+
+	hCtrl.SendMessage(msgSet, which, win.LPARAM(hNewImg))
+	hImg.Destroy()
+}
+
 var globalNextCtrlId uint16 = 0xdfff // https://stackoverflow.com/a/18192766/6923555
 
 // Returns an unique child control ID.
@@ -68,8 +243,9 @@ func (wndTy _WNDTY) initMsg() co.WM {
 	}
 }
 
-// Calculates the bound rectangle to fit the text with current UI font.
-func calcTextBoundBox(text string) (win.SIZE, error) {
+// Calculates the bound rectangle to fit the text with current UI font, scaled
+// to the DPI of the given window; if zero, the system DPI is used.
+func calcTextBoundBox(hWnd win.HWND, text string) (win.SIZE, error) {
 	isTextEmpty := false
 	if len([]rune(text)) == 0 {
 		isTextEmpty = true
@@ -89,7 +265,7 @@ func calcTextBoundBox(text string) (win.SIZE, error) {
 	}
 	defer hdcCloned.DeleteDC()
 
-	prevFont, err := hdcCloned.SelectObjectFont(globalUiFont)
+	prevFont, err := hdcCloned.SelectObjectFont(uiFontForDpi(windowDpi(hWnd)))
 	if err != nil {
 		return win.SIZE{}, err
 	}
@@ -107,23 +283,32 @@ func calcTextBoundBox(text string) (win.SIZE, error) {
 }
 
 // Calculates the bound rectangle to fit the text with current UI font,
-// including the check box for a checkbox/radio.
-func calcTextBoundBoxWithCheck(text string) (win.SIZE, error) {
-	boundBox, err := calcTextBoundBox(text)
+// including the check box for a checkbox/radio, scaled to the DPI of the given
+// window; if zero, the system DPI is used.
+func calcTextBoundBoxWithCheck(hWnd win.HWND, text string) (win.SIZE, error) {
+	boundBox, err := calcTextBoundBox(hWnd, text)
 	if err != nil {
 		return win.SIZE{}, err
 	}
 
-	boundBox.Cx += win.GetSystemMetrics(co.SM_CXMENUCHECK) + // https://stackoverflow.com/a/1165052/6923555
-		win.GetSystemMetrics(co.SM_CXEDGE)
+	sysDpi, dpi := systemDpi(), windowDpi(hWnd)                      // system metrics are given in system DPI
+	boundBox.Cx += scaleDpi(win.GetSystemMetrics(co.SM_CXMENUCHECK)+ // https://stackoverflow.com/a/1165052/6923555
+		win.GetSystemMetrics(co.SM_CXEDGE), sysDpi, dpi)
 
-	cyCheck := win.GetSystemMetrics(co.SM_CYMENUCHECK)
+	cyCheck := scaleDpi(win.GetSystemMetrics(co.SM_CYMENUCHECK), sysDpi, dpi)
 	if cyCheck > boundBox.Cy {
 		boundBox.Cy = cyCheck // if the check is taller than the font, use its height
 	}
 	return boundBox, nil
 }
 
+// Returns true if the point lies within the rectangle, which excludes its right
+// and bottom edges, like the PtInRect function does.
+func ptInRect(rc win.RECT, pt win.POINT) bool {
+	return pt.X >= rc.Left && pt.X < rc.Right &&
+		pt.Y >= rc.Top && pt.Y < rc.Bottom
+}
+
 // Paints the border of a child control according to the system theme.
 func paintThemedBorders(hWnd win.HWND, p WmNcPaint) {
 	hWnd.DefWindowProc(co.WM_NCPAINT, p.Raw.WParam, p.Raw.LParam) // make system draw the scrollbar for us
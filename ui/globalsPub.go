@@ -7,40 +7,67 @@ import (
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
-// Global system DPI factor.
-var dpiX, dpiY int = 0, 0
-
-func cacheSystemDpi() error {
-	if dpiX == 0 || dpiY == 0 { // not cached yet?
-		hdcScreen, err := win.HWND(0).GetDC()
-		if err != nil {
-			return err
-		}
-		defer win.HWND(0).ReleaseDC(hdcScreen)
-
-		dpiX = int(hdcScreen.GetDeviceCaps(co.GDC_LOGPIXELSX))
-		dpiY = int(hdcScreen.GetDeviceCaps(co.GDC_LOGPIXELSY))
-	}
-	return nil
-}
-
-// Returns the value adjusted according to the current horizontal system DPI.
+// Returns the value adjusted according to the horizontal system DPI. See [Dpi]
+// for the values used when creating controls; to adjust values to the monitor
+// where a window is, use [DpiXFor].
 func DpiX(x int) int {
-	cacheSystemDpi()
-	return x * dpiX / 96
+	return x * systemDpi() / 96
 }
 
-// Returns the value adjusted according to the current vertical system DPI.
+// Returns the value adjusted according to the vertical system DPI. See [Dpi]
+// for the values used when creating controls; to adjust values to the monitor
+// where a window is, use [DpiYFor].
 func DpiY(y int) int {
-	cacheSystemDpi()
-	return y * dpiY / 96
+	return y * systemDpi() / 96
 }
 
-// Returns the value adjusted according to the current system DPI.
+// Returns the value adjusted according to the system DPI.
+//
+// Positions and sizes given to the controls are always adjusted to the system
+// DPI; if the parent window is in a monitor with a different DPI, they are
+// converted when the control is created. For values used after the creation,
+// use [DpiFor].
 func Dpi(x, y int) (int, int) {
 	return DpiX(x), DpiY(y)
 }
 
+// Returns the DPI of the monitor where the window currently is, which changes
+// when the window is dragged to another monitor.
+//
+// If the window was not created yet, or the process is not per-monitor DPI
+// aware – which happens before Windows 10 version 1703 –, returns the system
+// DPI.
+func DpiOf(wnd Window) int {
+	return windowDpi(wnd.Hwnd())
+}
+
+// Returns the value adjusted according to the DPI of the monitor where the
+// window currently is.
+func DpiXFor(wnd Window, x int) int {
+	return x * DpiOf(wnd) / 96
+}
+
+// Returns the value adjusted according to the DPI of the monitor where the
+// window currently is.
+func DpiYFor(wnd Window, y int) int {
+	return y * DpiOf(wnd) / 96
+}
+
+// Returns the values adjusted according to the DPI of the monitor where the
+// window currently is.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	cx, cy := ui.DpiFor(wnd, 300, 200)
+//	wnd.Hwnd().SetWindowPos(win.HWND(0), 0, 0, uint(cx), uint(cy),
+//		co.SWP_NOZORDER|co.SWP_NOMOVE)
+func DpiFor(wnd Window, x, y int) (int, int) {
+	dpi := DpiOf(wnd)
+	return x * dpi / 96, y * dpi / 96
+}
+
 // Syntactic sugar to [TaskDialogIndirect] to display a message box indicating
 // an error.
 //
@@ -3,6 +3,8 @@
 package ui
 
 import (
+	"time"
+
 	"github.com/rodrigocfd/windigo/win"
 )
 
@@ -35,6 +37,36 @@ type ChildControl interface {
 type Parent interface {
 	Window
 
+	// Creates a [Timer] which calls fun once, in the UI thread, after the given
+	// duration.
+	//
+	// If the window was not created yet, the timer starts when it's created.
+	//
+	// # Example
+	//
+	//	var wnd ui.Parent // initialized somewhere
+	//
+	//	wnd.AfterFunc(2*time.Second, func() {
+	//		println("Two seconds later")
+	//	})
+	AfterFunc(d time.Duration, fun func()) *Timer
+
+	// Creates a [Timer] which calls fun repeatedly, in the UI thread, at the
+	// given interval, until it's stopped.
+	//
+	// If the window was not created yet, the timer starts when it's created.
+	//
+	// # Example
+	//
+	//	var wnd ui.Parent // initialized somewhere
+	//
+	//	tmr := wnd.Every(time.Second, func() {
+	//		println("Tick")
+	//	})
+	//	// later...
+	//	tmr.Stop()
+	Every(interval time.Duration, fun func()) *Timer
+
 	// Exposes all the window notifications the can be handled.
 	//
 	// Cannot be called after the window was created.
@@ -222,6 +222,16 @@ type WmDisplayChange struct{ Raw Wm }
 func (p WmDisplayChange) BitsPerPixel() int { return int(p.Raw.WParam) }
 func (p WmDisplayChange) Size() win.SIZE    { return p.Raw.LParam.MakeSize() }
 
+// [WM_DPICHANGED] parameters.
+//
+// [WM_DPICHANGED]: https://learn.microsoft.com/en-us/windows/win32/hidpi/wm-dpichanged
+type WmDpiChanged struct{ Raw Wm }
+
+func (p WmDpiChanged) Dpi() int { return int(p.Raw.WParam.HiWord()) }
+func (p WmDpiChanged) SuggestedRect() *win.RECT {
+	return (*win.RECT)(unsafe.Pointer(p.Raw.LParam))
+}
+
 // [WM_DRAWITEM] parameters.
 //
 // [WM_DRAWITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-drawitem
@@ -396,6 +406,24 @@ func (p WmInitMenuPopup) HMenu() win.HMENU   { return win.HMENU(p.Raw.WParam) }
 func (p WmInitMenuPopup) Pos() int           { return int(p.Raw.LParam.LoWord()) }
 func (p WmInitMenuPopup) IsWindowMenu() bool { return p.Raw.LParam.HiWord() != 0 }
 
+// [WM_INPUT] parameters.
+//
+// [WM_INPUT]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-input
+type WmInput struct{ Raw Wm }
+
+func (p WmInput) Code() co.RIM                     { return co.RIM(p.Raw.WParam & 0xff) }
+func (p WmInput) HRawInput() win.HRAWINPUT         { return win.HRAWINPUT(p.Raw.LParam) }
+func (p WmInput) IsForeground() bool               { return p.Code() == co.RIM_INPUT }
+func (p WmInput) RawInput() (*win.RAWINPUT, error) { return p.HRawInput().GetRawInputData() }
+
+// [WM_INPUT_DEVICE_CHANGE] parameters.
+//
+// [WM_INPUT_DEVICE_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-input-device-change
+type WmInputDeviceChange struct{ Raw Wm }
+
+func (p WmInputDeviceChange) Event() co.GIDC      { return co.GIDC(p.Raw.WParam) }
+func (p WmInputDeviceChange) HDevice() win.HANDLE { return win.HANDLE(p.Raw.LParam) }
+
 // Parameters for:
 //   - [WM_KEYDOWN]
 //   - [WM_KEYUP]
@@ -507,6 +535,20 @@ func (p WmMouse) IsXBtn1() bool      { return (p.VirtualKeys() & co.MK_XBUTTON1)
 func (p WmMouse) IsXBtn2() bool      { return (p.VirtualKeys() & co.MK_XBUTTON2) != 0 }
 func (p WmMouse) Pos() win.POINT     { return p.Raw.LParam.MakePoint() }
 
+// Parameters for:
+//   - [WM_MOUSEHWHEEL]
+//   - [WM_MOUSEWHEEL]
+//
+// [WM_MOUSEHWHEEL]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mousehwheel
+// [WM_MOUSEWHEEL]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mousewheel
+type WmMouseWheel struct{ Raw Wm }
+
+func (p WmMouseWheel) VirtualKeys() co.MK   { return co.MK(p.Raw.WParam.LoWord()) }
+func (p WmMouseWheel) HasCtrl() bool        { return (p.VirtualKeys() & co.MK_CONTROL) != 0 }
+func (p WmMouseWheel) HasShift() bool       { return (p.VirtualKeys() & co.MK_SHIFT) != 0 }
+func (p WmMouseWheel) Delta() int           { return int(int16(p.Raw.WParam.HiWord())) }
+func (p WmMouseWheel) PosScreen() win.POINT { return p.Raw.LParam.MakePoint() }
+
 // [WM_MOVE] parameters.
 //
 // [WM_MOVE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-move
@@ -676,6 +718,15 @@ func (p WmScroll) ScrollBoxPos() int       { return int(p.Raw.WParam.HiWord()) }
 func (p WmScroll) Request() co.SB_REQ      { return co.SB_REQ(p.Raw.WParam.LoWord()) }
 func (p WmScroll) HwndScrollbar() win.HWND { return win.HWND(p.Raw.LParam) }
 
+// [WM_SETCURSOR] parameters.
+//
+// [WM_SETCURSOR]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-setcursor
+type WmSetCursor struct{ Raw Wm }
+
+func (p WmSetCursor) HwndUnderCursor() win.HWND { return win.HWND(p.Raw.WParam) }
+func (p WmSetCursor) HitTest() co.HT            { return co.HT(int16(p.Raw.LParam.LoWord())) }
+func (p WmSetCursor) MouseMsg() co.WM           { return co.WM(p.Raw.LParam.HiWord()) }
+
 // [WM_SETFOCUS] parameters.
 //
 // [WM_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-setfocus
@@ -713,6 +764,19 @@ type WmSetText struct{ Raw Wm }
 
 func (p WmSetText) Text() *uint16 { return (*uint16)(unsafe.Pointer(p.Raw.LParam)) }
 
+// [WM_SETTINGCHANGE] parameters.
+//
+// [WM_SETTINGCHANGE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-settingchange
+type WmSettingChange struct{ Raw Wm }
+
+func (p WmSettingChange) Flag() co.SPI { return co.SPI(p.Raw.WParam) }
+func (p WmSettingChange) Area() string {
+	if p.Raw.LParam == 0 {
+		return ""
+	}
+	return wstr.DecodePtr((*uint16)(unsafe.Pointer(p.Raw.LParam)))
+}
+
 // [WM_SHOWWINDOW] parameters.
 //
 // [WM_SHOWWINDOW]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-showwindow
@@ -781,6 +845,15 @@ type WmUnInitMenuPopup struct{ Raw Wm }
 
 func (p WmUnInitMenuPopup) Hmenu() win.HMENU { return win.HMENU(p.Raw.WParam) }
 
+// [WM_VKEYTOITEM] parameters.
+//
+// [WM_VKEYTOITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-vkeytoitem
+type WmVKeyToItem struct{ Raw Wm }
+
+func (p WmVKeyToItem) VirtualKeyCode() co.VK { return co.VK(p.Raw.WParam.LoWord()) }
+func (p WmVKeyToItem) CurrentCaretPos() int  { return int(p.Raw.WParam.HiWord()) }
+func (p WmVKeyToItem) HwndListBox() win.HWND { return win.HWND(p.Raw.LParam) }
+
 // Parameters for:
 //   - [WM_HSCROLLCLIPBOARD]
 //   - [WM_VSCROLLCLIPBOARD]
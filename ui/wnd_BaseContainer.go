@@ -18,9 +18,21 @@ type _BaseContainer struct {
 	wndTy  _WNDTY
 	layout _Layout
 
+	dpi      int                        // Current DPI, to rescale the children when it changes.
+	dpiFuncs []func(oldDpi, newDpi int) // Called after the children were rescaled to a new DPI.
+
+	accels []win.ACCEL // Added with AddAccelerator.
+	hAccel win.HACCEL  // Built on demand from accels.
+
+	timers        map[uintptr]*Timer      // Running timers, by ID.
+	timersHooked  bool                    // Timer message handlers were installed.
+	hotKeys       map[int32]*GlobalHotKey // Registered hotkeys, by ID.
+	hotKeysHooked bool                    // Hotkey message handlers were installed.
+
 	beforeUserEvents EventsWindow
 	userEvents       EventsWindow
 	afterUserEvents  EventsWindow
+	fallbackEvents   EventsWindow // Internal, used only if no user closure handled the message.
 }
 
 // Constructor.
@@ -33,6 +45,7 @@ func newBaseContainer(wndTy _WNDTY) _BaseContainer {
 		beforeUserEvents: newEventsWindow(_WNDTY_DLG),
 		userEvents:       newEventsWindow(_WNDTY_DLG),
 		afterUserEvents:  newEventsWindow(_WNDTY_DLG),
+		fallbackEvents:   newEventsWindow(_WNDTY_DLG),
 	}
 }
 
@@ -40,11 +53,16 @@ func (me *_BaseContainer) clearMessages() {
 	me.beforeUserEvents.clear()
 	me.userEvents.clear()
 	me.afterUserEvents.clear()
+	me.fallbackEvents.clear()
+	me.dpiFuncs = nil
+	me.timersHooked = false
+	me.hotKeysHooked = false
 }
 func (me *_BaseContainer) removeWmCreateInitdialog() {
 	me.beforeUserEvents.removeWmCreateInitdialog()
 	me.userEvents.removeWmCreateInitdialog()
 	me.afterUserEvents.removeWmCreateInitdialog()
+	me.fallbackEvents.removeWmCreateInitdialog()
 }
 
 func (me *_BaseContainer) uiThread(fun func()) {
@@ -71,6 +89,156 @@ func (me *_BaseContainer) defaultMessageHandlers() {
 	me.beforeUserEvents.WmSize(func(p WmSize) {
 		me.layout.Rearrange(p)
 	})
+
+	me.dpiMessageHandlers()
+	me.themeMessageHandlers()
+}
+
+// Handles the DPI changes of the window, which happen when a per-monitor DPI
+// aware window is dragged to a monitor with a different DPI.
+func (me *_BaseContainer) dpiMessageHandlers() {
+	me.beforeUserEvents.Wm(me.wndTy.initMsg(), func(_ Wm) uintptr {
+		me.rescaleDpi(windowDpi(me.hWnd)) // the window may have been created in another monitor
+		return 0                          // ignored
+	})
+
+	me.beforeUserEvents.Wm(co.WM_DPICHANGED, func(p Wm) uintptr { // top-level windows only
+		me.rescaleDpi(int(p.WParam.HiWord()))
+		if me.wndTy == _WNDTY_RAW { // dialogs are resized by the system
+			rc := WmDpiChanged{Raw: p}.SuggestedRect()
+			me.hWnd.SetWindowPos(win.HWND(0), int(rc.Left), int(rc.Top),
+				uint(rc.Right-rc.Left), uint(rc.Bottom-rc.Top),
+				co.SWP_NOZORDER|co.SWP_NOACTIVATE)
+		}
+		return 0 // ignored
+	})
+
+	me.beforeUserEvents.Wm(co.WM_DPICHANGED_BEFOREPARENT, func(_ Wm) uintptr { // child windows only
+		me.rescaleDpi(windowDpi(me.hWnd))
+		return 0 // ignored
+	})
+}
+
+// Applies the color theme set with SetTheme() to the window and its children.
+func (me *_BaseContainer) themeMessageHandlers() {
+	me.afterUserEvents.Wm(me.wndTy.initMsg(), func(_ Wm) uintptr {
+		globalThemedWnds[me] = struct{}{}
+		if globalThemeDark { // light is the default appearance
+			me.applyTheme()
+		}
+		return 0 // ignored
+	})
+
+	me.beforeUserEvents.WmNcDestroy(func() {
+		delete(globalThemedWnds, me)
+	})
+
+	me.beforeUserEvents.WmSettingChange(func(p WmSettingChange) {
+		if globalTheme == THEME_SYSTEM && p.Area() == "ImmersiveColorSet" { // app mode may have changed
+			refreshTheme()
+		}
+	})
+
+	// The color messages are fallback events, so the user can still override them.
+	msgs := []co.WM{co.WM_CTLCOLORBTN, co.WM_CTLCOLORDLG, co.WM_CTLCOLOREDIT,
+		co.WM_CTLCOLORLISTBOX, co.WM_CTLCOLORSTATIC}
+	for _, msg := range msgs {
+		me.fallbackEvents.Wm(msg, func(p Wm) uintptr {
+			if !globalThemeDark {
+				return me.defaultProcessing(p)
+			}
+			editable := p.Msg == co.WM_CTLCOLOREDIT || p.Msg == co.WM_CTLCOLORLISTBOX
+			bk, text := darkColors(editable)
+			hdc := win.HDC(p.WParam)
+			hdc.SetTextColor(text)
+			hdc.SetBkColor(bk)
+			return uintptr(darkBrush(editable))
+		})
+	}
+
+	if me.wndTy == _WNDTY_RAW { // dialogs are painted with WM_CTLCOLORDLG
+		me.fallbackEvents.Wm(co.WM_ERASEBKGND, func(p Wm) uintptr {
+			if !globalThemeDark {
+				return me.defaultProcessing(p)
+			}
+			rc, _ := me.hWnd.GetClientRect()
+			win.HDC(p.WParam).FillRect(&rc, darkBrush(false))
+			return 1 // background erased
+		})
+	}
+}
+
+// Returns what the system would return if the message were not handled.
+func (me *_BaseContainer) defaultProcessing(p Wm) uintptr {
+	if me.wndTy == _WNDTY_RAW {
+		return me.hWnd.DefWindowProc(p.Msg, p.WParam, p.LParam)
+	}
+	return 0 // FALSE, so the dialog manager processes the message
+}
+
+// Applies the current theme to the window and its direct children; the other
+// containers among the children apply it to their own children.
+func (me *_BaseContainer) applyTheme() {
+	if style, _ := me.hWnd.Style(); (style & co.WS_CHILD) == 0 {
+		// Fails before Windows 10 version 2004, keeping the light caption.
+		me.hWnd.DwmSetWindowAttribute(win.DwmAttrUseImmersiveDarkMode(globalThemeDark))
+	}
+	setCtrlTheme(me.hWnd, "DarkMode_Explorer") // scroll bars of the window itself
+
+	hChild, _ := me.hWnd.GetWindow(co.GW_CHILD)
+	for hChild != 0 {
+		applyCtrlTheme(hChild)
+		hChild, _ = hChild.GetWindow(co.GW_HWNDNEXT)
+	}
+
+	me.hWnd.RedrawWindow(nil, win.HRGN(0),
+		co.RDW_ERASE|co.RDW_FRAME|co.RDW_INVALIDATE|co.RDW_ALLCHILDREN)
+}
+
+// Adds a function to be called when the window DPI changes, after the layout
+// and the children were rescaled.
+func (me *_BaseContainer) onDpiChanged(fun func(oldDpi, newDpi int)) {
+	me.dpiFuncs = append(me.dpiFuncs, fun)
+}
+
+// Rescales the layout and the direct children from the current DPI to the new
+// one, replacing the UI font. Children of dialogs are rescaled by the system,
+// and the other containers among the children rescale their own children.
+func (me *_BaseContainer) rescaleDpi(newDpi int) {
+	oldDpi := me.dpi
+	if oldDpi == 0 { // first call, when the window is created
+		oldDpi = systemDpi() // values given by the user are in system DPI
+	}
+	me.dpi = newDpi
+	if oldDpi == newDpi {
+		return
+	}
+
+	me.layout.scaleDpi(oldDpi, newDpi)
+
+	if me.wndTy == _WNDTY_RAW {
+		hOldFont, hNewFont := uiFontForDpi(oldDpi), uiFontForDpi(newDpi)
+		hChild, _ := me.hWnd.GetWindow(co.GW_CHILD)
+		for hChild != 0 {
+			rc, _ := hChild.GetWindowRect()
+			me.hWnd.ScreenToClientRc(&rc)
+			x, y := scaleDpi(rc.Left, oldDpi, newDpi), scaleDpi(rc.Top, oldDpi, newDpi)
+			cx := scaleDpi(rc.Right, oldDpi, newDpi) - x
+			cy := scaleDpi(rc.Bottom, oldDpi, newDpi) - y
+			hChild.SetWindowPos(win.HWND(0), int(x), int(y), uint(cx), uint(cy),
+				co.SWP_NOZORDER|co.SWP_NOACTIVATE)
+
+			if hFont, _ := hChild.SendMessage(co.WM_GETFONT, 0, 0); hOldFont != 0 &&
+				win.HFONT(hFont) == hOldFont { // fonts set by the user are kept
+				hChild.SendMessage(co.WM_SETFONT, win.WPARAM(hNewFont), win.LPARAM(1))
+			}
+			hChild, _ = hChild.GetWindow(co.GW_HWNDNEXT)
+		}
+	}
+
+	for _, fun := range me.dpiFuncs {
+		fun(oldDpi, newDpi)
+	}
 }
 
 func (me *_BaseContainer) runMainLoop(hAccel win.HACCEL, processDlgMsgs bool) int {
@@ -96,7 +264,10 @@ func (me *_BaseContainer) runMainLoop(hAccel win.HACCEL, processDlgMsgs bool) in
 			hTopLevel = pMsg.HWnd
 		}
 
-		// If we have an accelerator table, try to translate the message.
+		// Try the accelerators added to the windows, then the accelerator table.
+		if translateAccelerators(pMsg) {
+			continue // message translated
+		}
 		if hAccel != 0 && hTopLevel.TranslateAccelerator(hAccel, pMsg) == nil {
 			continue // message translated
 		}
@@ -134,6 +305,11 @@ func (me *_BaseContainer) runModalLoop(processDlgMsgs bool) {
 			hWndTopLevel = me.hWnd
 		}
 
+		// Try the accelerators added to the windows.
+		if translateAccelerators(pMsg) {
+			continue // message translated
+		}
+
 		// Try to process keyboard actions for child controls.
 		if processDlgMsgs && hWndTopLevel.IsDialogMessage(pMsg) {
 			// Processed all keyboard actions for child controls.
@@ -11,21 +11,27 @@ import (
 )
 
 // Base to all dialog-based windows created with CreateDialogParam and
-// DialogBoxParam.
+// DialogBoxParam, or their indirect counterparts.
 type _BaseDlg struct {
 	_BaseContainer
-	dlgId uint16
+	dlgId    uint16
+	template []byte // Serialized DLGTEMPLATEEX, used instead of the resource ID.
 }
 
-// Constructor.
-func newBaseDlg(dlgId uint16) _BaseDlg {
-	if dlgId == 0 {
-		panic("Dialog ID must be specified.")
+// Constructor. If tmpl is not nil, the dialog is created from it, and dlgId is
+// ignored.
+func newBaseDlg(dlgId uint16, tmpl *win.DLGTEMPLATEEX) _BaseDlg {
+	var template []byte
+	if tmpl != nil {
+		template = tmpl.Serialize()
+	} else if dlgId == 0 {
+		panic("Dialog ID or template must be specified.")
 	}
 
 	return _BaseDlg{
 		_BaseContainer: newBaseContainer(_WNDTY_DLG),
 		dlgId:          dlgId,
+		template:       template,
 	}
 }
 
@@ -36,8 +42,14 @@ func (me *_BaseDlg) createDialogParam(hInst win.HINSTANCE, hParent win.HWND) {
 	dlgProcCallback()
 
 	// The hWnd member is saved in WM_INITDIALOG processing in dlgProc.
-	_, err := hInst.CreateDialogParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
-		win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	var err error
+	if me.template != nil {
+		_, err = hInst.CreateDialogIndirectParam(me.templatePtr(), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		_, err = hInst.CreateDialogParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me)))
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -49,13 +61,25 @@ func (me *_BaseDlg) dialogBoxParam(hInst win.HINSTANCE, hParent win.HWND) {
 	}
 
 	// The hWnd member is saved in WM_INITDIALOG processing in dlgProc.
-	_, err := hInst.DialogBoxParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
-		win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	var err error
+	if me.template != nil {
+		_, err = hInst.DialogBoxIndirectParam(me.templatePtr(), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		_, err = hInst.DialogBoxParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me)))
+	}
 	if err != nil {
 		panic(err)
 	}
 }
 
+// Returns the serialized template, whose header has a different layout than
+// DLGTEMPLATE, but which is accepted by the same functions.
+func (me *_BaseDlg) templatePtr() *win.DLGTEMPLATE {
+	return (*win.DLGTEMPLATE)(unsafe.Pointer(&me.template[0]))
+}
+
 func (me *_BaseDlg) setIcon(hInst win.HINSTANCE, iconId uint16) error {
 	hGdiobjIcon, err := hInst.LoadImage(win.ResIdInt(iconId),
 		co.IMAGE_ICON, 16, 16, co.LR_DEFAULTCOLOR|co.LR_SHARED)
@@ -104,8 +128,11 @@ func dlgProcCallback() uintptr {
 			msg := Wm{uMsg, wParam, lParam}
 			atLeastOneBeforeUser := pMe.beforeUserEvents.processAllMessages(msg)
 
-			// Execute user closure, if any.
+			// Execute user closure, if any; otherwise, the internal fallback closure, if any.
 			userRet, hasUserRet := pMe.userEvents.processLastMessage(msg)
+			if !hasUserRet {
+				userRet, hasUserRet = pMe.fallbackEvents.processLastMessage(msg)
+			}
 
 			// Execute post-user closures, keep track if at least one was executed.
 			atLeastOneAfterUser := pMe.afterUserEvents.processAllMessages(msg)
@@ -119,6 +146,13 @@ func dlgProcCallback() uintptr {
 				pMe.clearMessages()
 			}
 
+			switch uMsg {
+			case co.WM_DPICHANGED, co.WM_DPICHANGED_BEFOREPARENT, co.WM_DPICHANGED_AFTERPARENT:
+				return 0 // FALSE, so the dialog manager rescales the dialog and its controls
+			case co.WM_INPUT:
+				return 0 // FALSE, so DefWindowProc frees the raw input data
+			}
+
 			if hasUserRet {
 				return userRet
 			} else if atLeastOneBeforeUser || atLeastOneAfterUser {
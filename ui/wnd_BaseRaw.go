@@ -91,6 +91,10 @@ func (me *_BaseRaw) createWindow(
 		panic("Cannot create window twice.")
 	}
 
+	if (style & co.WS_CHILD) != 0 {
+		pos, size = scaleToParentDpi(hParent, pos, size)
+	}
+
 	// The hWnd member is saved in WM_NCCREATE processing in wndProc.
 	_, err := win.CreateWindowEx(exStyle, win.ClassNameAtom(className),
 		title, style, int(pos.X), int(pos.Y), uint(size.Cx), uint(size.Cy),
@@ -143,8 +147,11 @@ func wndProcCallback() uintptr {
 			msg := Wm{uMsg, wParam, lParam}
 			atLeastOneBeforeUser := pMe.beforeUserEvents.processAllMessages(msg)
 
-			// Execute user closure, if any.
+			// Execute user closure, if any; otherwise, the internal fallback closure, if any.
 			userRet, hasUserRet := pMe.userEvents.processLastMessage(msg)
+			if !hasUserRet {
+				userRet, hasUserRet = pMe.fallbackEvents.processLastMessage(msg)
+			}
 
 			// Execute post-user closures, keep track if at least one was executed.
 			atLeastOneAfterUser := pMe.afterUserEvents.processAllMessages(msg)
@@ -158,6 +165,11 @@ func wndProcCallback() uintptr {
 				pMe.clearMessages()
 			}
 
+			if uMsg == co.WM_INPUT { // even if handled, DefWindowProc must free the raw input data
+				hWnd.DefWindowProc(uMsg, wParam, lParam)
+				return 0
+			}
+
 			if hasUserRet {
 				return userRet
 			} else if atLeastOneBeforeUser || atLeastOneAfterUser {
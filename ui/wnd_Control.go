@@ -3,6 +3,8 @@
 package ui
 
 import (
+	"time"
+
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
 )
@@ -44,6 +46,22 @@ func (me *Control) Parent() Parent {
 	return me.parent
 }
 
+// Creates a [Timer] which calls fun once, in the UI thread, after the given
+// duration.
+//
+// Implements [Parent].
+func (me *Control) AfterFunc(d time.Duration, fun func()) *Timer {
+	return me.base().newTimer(d, false, fun)
+}
+
+// Creates a [Timer] which calls fun repeatedly, in the UI thread, at the given
+// interval, until it's stopped.
+//
+// Implements [Parent].
+func (me *Control) Every(interval time.Duration, fun func()) *Timer {
+	return me.base().newTimer(interval, true, fun)
+}
+
 // Returns the underlying HWND handle of this window.
 //
 // Implements [Window].
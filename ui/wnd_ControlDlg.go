@@ -16,7 +16,7 @@ type _ControlDlg struct {
 func newControlDlg(parent Parent, opts *VarOptsControlDlg) *_ControlDlg {
 	setUniqueCtrlId(&opts.ctrlId)
 	me := &_ControlDlg{
-		_BaseDlg: newBaseDlg(opts.dlgId),
+		_BaseDlg: newBaseDlg(opts.dlgId, nil),
 		ctrlId:   opts.ctrlId,
 	}
 
@@ -36,6 +36,8 @@ func newControlDlg(parent Parent, opts *VarOptsControlDlg) *_ControlDlg {
 }
 
 func (me *_ControlDlg) defaultMessageHandlers() {
+	me._BaseDlg._BaseContainer.defaultMessageHandlers()
+
 	me.userEvents.WmNcPaint(func(p WmNcPaint) {
 		paintThemedBorders(me.hWnd, p)
 	})
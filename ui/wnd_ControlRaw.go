@@ -35,6 +35,8 @@ func newControlRaw(parent Parent, opts *VarOptsControl) *_ControlRaw {
 }
 
 func (me *_ControlRaw) defaultMessageHandlers() {
+	me._BaseRaw._BaseContainer.defaultMessageHandlers()
+
 	me.userEvents.WmNcPaint(func(p WmNcPaint) {
 		paintThemedBorders(me.hWnd, p)
 	})
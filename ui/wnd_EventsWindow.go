@@ -512,6 +512,19 @@ func (me *EventsWindow) WmDisplayChange(fun func(p WmDisplayChange)) {
 	})
 }
 
+// [WM_DPICHANGED] message handler.
+//
+// Top-level windows are automatically resized to the suggested rectangle, and
+// their children are rescaled, before the handler is called.
+//
+// [WM_DPICHANGED]: https://learn.microsoft.com/en-us/windows/win32/hidpi/wm-dpichanged
+func (me *EventsWindow) WmDpiChanged(fun func(p WmDpiChanged)) {
+	me.Wm(co.WM_DPICHANGED, func(p Wm) uintptr {
+		fun(WmDpiChanged{Raw: p})
+		return me.defProcVal
+	})
+}
+
 // [WM_DRAWCLIPBOARD] message handler.
 //
 // [WM_DRAWCLIPBOARD]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-drawclipboard
@@ -839,6 +852,49 @@ func (me *EventsWindow) WmInitMenuPopup(fun func(p WmInitMenuPopup)) {
 	})
 }
 
+// [WM_INPUT] message handler.
+//
+// Only received after the devices are registered with
+// [win.RegisterRawInputDevices]. After the closure, the message is always
+// passed to DefWindowProc, which frees the raw input data.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	wnd.On().WmCreate(func(_ ui.WmCreate) int {
+//		win.RegisterRawInputDevices([]win.RAWINPUTDEVICE{
+//			{UsUsagePage: 0x01, UsUsage: 0x02, HwndTarget: wnd.Hwnd()},
+//		})
+//		return 0
+//	})
+//
+//	wnd.On().WmInput(func(p ui.WmInput) {
+//		if ri, err := p.RawInput(); err == nil && ri.Header.DwType == co.RIM_TYPEMOUSE {
+//			println(ri.Header.HDevice, ri.Mouse().LLastX, ri.Mouse().LLastY)
+//		}
+//	})
+//
+// [WM_INPUT]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-input
+func (me *EventsWindow) WmInput(fun func(p WmInput)) {
+	me.Wm(co.WM_INPUT, func(p Wm) uintptr {
+		fun(WmInput{Raw: p})
+		return me.defProcVal
+	})
+}
+
+// [WM_INPUT_DEVICE_CHANGE] message handler.
+//
+// Only received for devices registered with co.RIDEV_DEVNOTIFY.
+//
+// [WM_INPUT_DEVICE_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-input-device-change
+func (me *EventsWindow) WmInputDeviceChange(fun func(p WmInputDeviceChange)) {
+	me.Wm(co.WM_INPUT_DEVICE_CHANGE, func(p Wm) uintptr {
+		fun(WmInputDeviceChange{Raw: p})
+		return me.defProcVal
+	})
+}
+
 // [WM_KEYDOWN] message handler.
 //
 // [WM_KEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-keydown
@@ -986,6 +1042,16 @@ func (me *EventsWindow) WmMenuSelect(fun func(p WmMenuSelect)) {
 	})
 }
 
+// [WM_MOUSEHWHEEL] message handler.
+//
+// [WM_MOUSEHWHEEL]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mousehwheel
+func (me *EventsWindow) WmMouseHWheel(fun func(p WmMouseWheel)) {
+	me.Wm(co.WM_MOUSEHWHEEL, func(p Wm) uintptr {
+		fun(WmMouseWheel{Raw: p})
+		return me.defProcVal
+	})
+}
+
 // [WM_MOUSEHOVER] message handler.
 //
 // [WM_MOUSEHOVER]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mousehover
@@ -1016,6 +1082,16 @@ func (me *EventsWindow) WmMouseMove(fun func(p WmMouse)) {
 	})
 }
 
+// [WM_MOUSEWHEEL] message handler.
+//
+// [WM_MOUSEWHEEL]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mousewheel
+func (me *EventsWindow) WmMouseWheel(fun func(p WmMouseWheel)) {
+	me.Wm(co.WM_MOUSEWHEEL, func(p Wm) uintptr {
+		fun(WmMouseWheel{Raw: p})
+		return me.defProcVal
+	})
+}
+
 // [WM_MOVE] message handler.
 //
 // [WM_MOVE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-move
@@ -1408,6 +1484,18 @@ func (me *EventsWindow) WmRenderFormat(fun func(p WmRenderFormat)) {
 	})
 }
 
+// [WM_SETCURSOR] message handler.
+//
+// Return true to halt further processing, or false to let the default window
+// procedure set the cursor.
+//
+// [WM_SETCURSOR]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-setcursor
+func (me *EventsWindow) WmSetCursor(fun func(p WmSetCursor) bool) {
+	me.Wm(co.WM_SETCURSOR, func(p Wm) uintptr {
+		return utl.BoolToUintptr(fun(WmSetCursor{Raw: p}))
+	})
+}
+
 // [WM_SETFOCUS] message handler.
 //
 // [WM_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-setfocus
@@ -1456,6 +1544,16 @@ func (me *EventsWindow) WmSetText(fun func(p WmSetText) uintptr) {
 	})
 }
 
+// [WM_SETTINGCHANGE] message handler.
+//
+// [WM_SETTINGCHANGE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-settingchange
+func (me *EventsWindow) WmSettingChange(fun func(p WmSettingChange)) {
+	me.Wm(co.WM_SETTINGCHANGE, func(p Wm) uintptr {
+		fun(WmSettingChange{Raw: p})
+		return me.defProcVal
+	})
+}
+
 // [WM_SHOWWINDOW] message handler.
 //
 // [WM_SHOWWINDOW]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-showwindow
@@ -1615,6 +1713,15 @@ func (me *EventsWindow) WmUndo(fun func() bool) {
 	})
 }
 
+// [WM_VKEYTOITEM] message handler.
+//
+// [WM_VKEYTOITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-vkeytoitem
+func (me *EventsWindow) WmVKeyToItem(fun func(p WmVKeyToItem) int) {
+	me.Wm(co.WM_VKEYTOITEM, func(p Wm) uintptr {
+		return uintptr(fun(WmVKeyToItem{Raw: p}))
+	})
+}
+
 // [WM_VSCROLL] message handler.
 //
 // [WM_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-vscroll
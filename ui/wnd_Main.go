@@ -3,6 +3,7 @@
 package ui
 
 import (
+	"time"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/win"
@@ -76,10 +77,8 @@ func NewMainDlg(opts *VarOptsMainDlg) *Main {
 //
 // Panics on error.
 func (me *Main) RunAsMain() int {
-	if win.IsWindowsVistaOrGreater() {
-		if err := win.SetProcessDPIAware(); err != nil {
-			panic(err)
-		}
+	if err := setProcessDpiAwareness(); err != nil {
+		panic(err)
 	}
 
 	win.InitCommonControls()
@@ -99,7 +98,8 @@ func (me *Main) RunAsMain() int {
 	}
 
 	createGlobalUiFont() // will be applied to native controls
-	defer globalUiFont.DeleteObject()
+	defer deleteGlobalUiFonts()
+	defer deleteGlobalDarkBrushes()
 
 	hInst, _ := win.GetModuleHandle("")
 	if me.raw != nil {
@@ -109,6 +109,22 @@ func (me *Main) RunAsMain() int {
 	}
 }
 
+// Creates a [Timer] which calls fun once, in the UI thread, after the given
+// duration.
+//
+// Implements [Parent].
+func (me *Main) AfterFunc(d time.Duration, fun func()) *Timer {
+	return me.base().newTimer(d, false, fun)
+}
+
+// Creates a [Timer] which calls fun repeatedly, in the UI thread, at the given
+// interval, until it's stopped.
+//
+// Implements [Parent].
+func (me *Main) Every(interval time.Duration, fun func()) *Timer {
+	return me.base().newTimer(interval, true, fun)
+}
+
 // Returns the underlying HWND handle of this window.
 //
 // Implements [Window].
@@ -16,7 +16,7 @@ type _MainDlg struct {
 // Constructor.
 func newMainDlg(opts *VarOptsMainDlg) *_MainDlg {
 	me := &_MainDlg{
-		_BaseDlg:     newBaseDlg(opts.dlgId),
+		_BaseDlg:     newBaseDlg(opts.dlgId, opts.template),
 		iconId:       opts.iconId,
 		accelTableId: opts.accelTableId,
 	}
@@ -59,6 +59,7 @@ func (me *_MainDlg) defaultMessageHandlers() {
 // Options for [NewMainDlg]; returned by [OptsMainDlg].
 type VarOptsMainDlg struct {
 	dlgId        uint16
+	template     *win.DLGTEMPLATEEX
 	iconId       uint16
 	accelTableId uint16
 }
@@ -70,11 +71,19 @@ func OptsMainDlg() *VarOptsMainDlg {
 
 // Dialog resource ID passed to [CreateDialogParam].
 //
-// Panics if not informed.
+// Panics if neither this nor Template is informed.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
 func (o *VarOptsMainDlg) DlgId(id uint16) *VarOptsMainDlg { o.dlgId = id; return o }
 
+// In-memory dialog template passed to [CreateDialogIndirectParam], used
+// instead of a dialog resource. If informed, DlgId is ignored.
+//
+// Defaults to none.
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (o *VarOptsMainDlg) Template(t *win.DLGTEMPLATEEX) *VarOptsMainDlg { o.template = t; return o }
+
 // Dialog icon ID passed to [WM_SETICON].
 //
 // Defaults to none.
@@ -3,6 +3,8 @@
 package ui
 
 import (
+	"time"
+
 	"github.com/rodrigocfd/windigo/win"
 )
 
@@ -52,7 +54,34 @@ func NewModal(parent Parent, opts *VarOptsModal) *Modal {
 func NewModalDlg(parent Parent, dlgId uint16) *Modal {
 	return &Modal{
 		raw: nil,
-		dlg: newModalDlg(parent, dlgId),
+		dlg: newModalDlg(parent, dlgId, nil),
+	}
+}
+
+// Creates a new dialog-based Modal with [DialogBoxIndirectParam], from an
+// in-memory template instead of a dialog resource.
+//
+// # Example
+//
+//	var wndParent ui.Parent // initialized somewhere
+//
+//	wndModal := ui.NewModalDlgTemplate(wndParent,
+//		&win.DLGTEMPLATEEX{
+//			Style: co.WS_CAPTION | co.WS_SYSMENU | co.WS_POPUP |
+//				co.WS(co.DS_MODALFRAME),
+//			Cx: 200, Cy: 100,
+//			Title:     "Hello modal",
+//			PointSize: 9,
+//			FaceName:  "Segoe UI",
+//		},
+//	)
+//	wndModal.ShowModal()
+//
+// [DialogBoxIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-dialogboxindirectparamw
+func NewModalDlgTemplate(parent Parent, tmpl *win.DLGTEMPLATEEX) *Modal {
+	return &Modal{
+		raw: nil,
+		dlg: newModalDlg(parent, 0, tmpl),
 	}
 }
 
@@ -66,6 +95,22 @@ func (me *Modal) ShowModal() {
 	}
 }
 
+// Creates a [Timer] which calls fun once, in the UI thread, after the given
+// duration.
+//
+// Implements [Parent].
+func (me *Modal) AfterFunc(d time.Duration, fun func()) *Timer {
+	return me.base().newTimer(d, false, fun)
+}
+
+// Creates a [Timer] which calls fun repeatedly, in the UI thread, at the given
+// interval, until it's stopped.
+//
+// Implements [Parent].
+func (me *Modal) Every(interval time.Duration, fun func()) *Timer {
+	return me.base().newTimer(interval, true, fun)
+}
+
 // Returns the underlying HWND handle of this window.
 //
 // Implements [Window].
@@ -12,9 +12,9 @@ type _ModalDlg struct {
 	parent Parent
 }
 
-func newModalDlg(parent Parent, dlgId uint16) *_ModalDlg {
+func newModalDlg(parent Parent, dlgId uint16, tmpl *win.DLGTEMPLATEEX) *_ModalDlg {
 	me := &_ModalDlg{
-		_BaseDlg: newBaseDlg(dlgId),
+		_BaseDlg: newBaseDlg(dlgId, tmpl),
 		parent:   parent,
 	}
 	me.defaultMessageHandlers()
@@ -0,0 +1,500 @@
+//go:build windows
+
+package ui
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Scrollable container of child controls.
+//
+// The panel has a virtual client area, which can be larger than its visible
+// area; in this case, scroll bars are displayed, and the child controls are
+// scrolled with the scroll bars and the mouse wheel. The layout of the child
+// controls is relative to the virtual client area.
+//
+// Implements:
+//   - [Window]
+//   - [ChildControl]
+//   - [Parent]
+type Panel struct {
+	_BaseRaw
+	ctrlId    uint16
+	parent    Parent
+	lineSize  int
+	wheelRest [2]int // accumulated wheel delta for high-resolution wheels, horizontal and vertical
+}
+
+// Creates a new [Panel] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	panel := ui.NewPanel(
+//		wndOwner,
+//		ui.OptsPanel().
+//			Size(ui.Dpi(300, 200)).
+//			VirtualSize(ui.Dpi(300, 800)).
+//			Layout(ui.LAY_RESIZE_RESIZE),
+//	)
+//	txt := ui.NewEdit(
+//		panel,
+//		ui.OptsEdit().
+//			Position(ui.Dpi(10, 700)).
+//			Layout(ui.LAY_RESIZE_NONE),
+//	)
+func NewPanel(parent Parent, opts *VarOptsPanel) *Panel {
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &Panel{
+		_BaseRaw: newBaseRaw(),
+		ctrlId:   opts.ctrlId,
+		parent:   parent,
+		lineSize: opts.lineSize,
+	}
+	me.layout.szVirt = opts.virtualSize
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		hInst, _ := parent.Hwnd().HInstance()
+		atom := me.registerClass(hInst, opts.className, opts.classStyle,
+			0, opts.classBrush, opts.classCursor)
+		me.createWindow(opts.exStyle, atom, "", opts.style,
+			opts.position, opts.size, parent.Hwnd(), win.HMENU(opts.ctrlId), hInst)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		me.updateScrollBars()
+		return 0 // ignored
+	})
+
+	me.defaultMessageHandlers()
+	return me
+}
+
+func (me *Panel) defaultMessageHandlers() {
+	me.beforeUserEvents.WmSize(func(p WmSize) {
+		if p.Request() != co.SIZE_REQ_MINIMIZED {
+			me.updateScrollBars() // must run before layout rearrangement
+		}
+	})
+
+	me._BaseContainer.defaultMessageHandlers()
+	me.onDpiChanged(func(oldDpi, newDpi int) {
+		me.lineSize = int(scaleDpi(int32(me.lineSize), oldDpi, newDpi))
+	})
+
+	me.beforeUserEvents.WmHScroll(func(p WmScroll) {
+		if p.HwndScrollbar() == 0 { // not from a child scroll bar control
+			me.scrollByRequest(co.SB_TYPE_HORZ, p.Request())
+		}
+	})
+
+	me.beforeUserEvents.WmVScroll(func(p WmScroll) {
+		if p.HwndScrollbar() == 0 {
+			me.scrollByRequest(co.SB_TYPE_VERT, p.Request())
+		}
+	})
+
+	// The wheel messages are handled here, never reaching DefWindowProc, which
+	// would forward them to the parent, so a scrollable parent would also scroll.
+	me.beforeUserEvents.Wm(co.WM_MOUSEWHEEL, func(p Wm) uintptr {
+		if parm := (WmMouseWheel{Raw: p}); parm.HasShift() {
+			me.scrollByWheel(co.SB_TYPE_HORZ, -parm.Delta()) // wheel up scrolls left
+		} else {
+			me.scrollByWheel(co.SB_TYPE_VERT, -parm.Delta())
+		}
+		return 0 // handled
+	})
+
+	me.beforeUserEvents.Wm(co.WM_MOUSEHWHEEL, func(p Wm) uintptr {
+		me.scrollByWheel(co.SB_TYPE_HORZ, WmMouseWheel{Raw: p}.Delta())
+		return 0 // handled
+	})
+
+	me.userEvents.WmNcPaint(func(p WmNcPaint) {
+		paintThemedBorders(me.hWnd, p)
+	})
+}
+
+// Returns the maximum scroll position of each axis.
+func (me *Panel) maxScrollPos() win.POINT {
+	rc, _ := me.hWnd.GetClientRect()
+	maxPos := win.POINT{
+		X: me.layout.szVirt.Cx - rc.Right,
+		Y: me.layout.szVirt.Cy - rc.Bottom,
+	}
+	if maxPos.X < 0 {
+		maxPos.X = 0
+	}
+	if maxPos.Y < 0 {
+		maxPos.Y = 0
+	}
+	return maxPos
+}
+
+// Sets range and page of both scroll bars, according to the virtual size and
+// the current client area, clamping the current position if needed.
+func (me *Panel) updateScrollBars() {
+	// Showing or hiding a scroll bar changes the client area, which sends a
+	// nested WM_SIZE, so the client area is retrieved for each scroll bar.
+	for _, bar := range []co.SB_TYPE{co.SB_TYPE_VERT, co.SB_TYPE_HORZ} {
+		rc, _ := me.hWnd.GetClientRect()
+
+		var si win.SCROLLINFO
+		si.SetCbSize()
+		si.FMask = co.SIF_RANGE | co.SIF_PAGE
+		if bar == co.SB_TYPE_HORZ {
+			si.NMax = me.layout.szVirt.Cx - 1
+			si.NPage = uint32(rc.Right)
+		} else {
+			si.NMax = me.layout.szVirt.Cy - 1
+			si.NPage = uint32(rc.Bottom)
+		}
+		if si.NMax < 0 {
+			si.NMax = 0
+		}
+		me.hWnd.SetScrollInfo(bar, &si, true)
+	}
+
+	me.ScrollTo(int(me.layout.offset.X), int(me.layout.offset.Y)) // clamp current position
+}
+
+func (me *Panel) scrollByRequest(bar co.SB_TYPE, req co.SB_REQ) {
+	var si win.SCROLLINFO
+	si.SetCbSize()
+	si.FMask = co.SIF_ALL
+	me.hWnd.GetScrollInfo(bar, &si)
+
+	newPos := int(scrollPosFromRequest(&si, req, me.lineSize))
+	if bar == co.SB_TYPE_HORZ {
+		me.ScrollTo(newPos, int(me.layout.offset.Y))
+	} else {
+		me.ScrollTo(int(me.layout.offset.X), newPos)
+	}
+}
+
+func (me *Panel) scrollByWheel(bar co.SB_TYPE, delta int) {
+	const WHEEL_DELTA = 120
+	const WHEEL_PAGESCROLL = ^uint32(0)
+
+	rest := &me.wheelRest[bar] // SB_TYPE_HORZ is 0, SB_TYPE_VERT is 1
+	*rest += delta
+	notches := *rest / WHEEL_DELTA
+	if notches == 0 {
+		return // wait until a full notch is accumulated
+	}
+	*rest -= notches * WHEEL_DELTA
+
+	var lines uint32
+	if err := win.SystemParametersInfo(co.SPI_GETWHEELSCROLLLINES,
+		0, unsafe.Pointer(&lines), co.SPIF(0)); err != nil {
+		lines = 3 // system default
+	}
+
+	var px int
+	if lines == WHEEL_PAGESCROLL {
+		rc, _ := me.hWnd.GetClientRect()
+		if bar == co.SB_TYPE_HORZ {
+			px = notches * int(rc.Right)
+		} else {
+			px = notches * int(rc.Bottom)
+		}
+	} else {
+		px = notches * int(lines) * me.lineSize
+	}
+
+	if bar == co.SB_TYPE_HORZ {
+		me.ScrollTo(int(me.layout.offset.X)+px, int(me.layout.offset.Y))
+	} else {
+		me.ScrollTo(int(me.layout.offset.X), int(me.layout.offset.Y)+px)
+	}
+}
+
+// Returns the control ID, unique within the same Parent.
+//
+// Implements [ChildControl].
+func (me *Panel) CtrlId() uint16 {
+	return me.ctrlId
+}
+
+// Calls [win.HWND.SetFocus].
+//
+// Implements [ChildControl].
+func (me *Panel) Focus() {
+	me.hWnd.SetFocus()
+}
+
+// Creates a [Timer] which calls fun once, in the UI thread, after the given
+// duration.
+//
+// Implements [Parent].
+func (me *Panel) AfterFunc(d time.Duration, fun func()) *Timer {
+	return me.base().newTimer(d, false, fun)
+}
+
+// Creates a [Timer] which calls fun repeatedly, in the UI thread, at the given
+// interval, until it's stopped.
+//
+// Implements [Parent].
+func (me *Panel) Every(interval time.Duration, fun func()) *Timer {
+	return me.base().newTimer(interval, true, fun)
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Implements [Window].
+//
+// Note that this handle is initially zero, existing only after window creation.
+func (me *Panel) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Exposes all the window notifications the can be handled.
+//
+// Implements [Parent].
+//
+// Panics if called after the window has been created.
+func (me *Panel) On() *EventsWindow {
+	if me.hWnd != 0 {
+		panic("Cannot add event handling after the window has been created.")
+	}
+	return &me.userEvents
+}
+
+// Returns the parent container of this panel.
+func (me *Panel) Parent() Parent {
+	return me.parent
+}
+
+// Returns the current scroll position, which is the point of the virtual
+// client area displayed at the top left corner of the panel.
+func (me *Panel) ScrollPos() (int, int) {
+	return int(me.layout.offset.X), int(me.layout.offset.Y)
+}
+
+// Scrolls the panel, if needed, so the given child control becomes entirely
+// visible.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Panel) ScrollIntoView(ctrl ChildControl) *Panel {
+	rcCtrl, _ := ctrl.Hwnd().GetWindowRect()
+	me.hWnd.ScreenToClientRc(&rcCtrl) // relative to the visible area
+	rcClient, _ := me.hWnd.GetClientRect()
+
+	x, y := int(me.layout.offset.X), int(me.layout.offset.Y)
+	if rcCtrl.Right > rcClient.Right {
+		x += int(rcCtrl.Right - rcClient.Right)
+	}
+	if rcCtrl.Left < 0 || rcCtrl.Right-rcCtrl.Left > rcClient.Right {
+		x = int(me.layout.offset.X + rcCtrl.Left)
+	}
+	if rcCtrl.Bottom > rcClient.Bottom {
+		y += int(rcCtrl.Bottom - rcClient.Bottom)
+	}
+	if rcCtrl.Top < 0 || rcCtrl.Bottom-rcCtrl.Top > rcClient.Bottom {
+		y = int(me.layout.offset.Y + rcCtrl.Top)
+	}
+	return me.ScrollTo(x, y)
+}
+
+// Scrolls the panel, so the given point of the virtual client area is
+// displayed at the top left corner, with [win.HWND.ScrollWindowEx]. The
+// position is clamped to the scrollable range.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Panel) ScrollTo(x, y int) *Panel {
+	maxPos := me.maxScrollPos()
+	newPos := win.POINT{X: int32(x), Y: int32(y)}
+	if newPos.X > maxPos.X {
+		newPos.X = maxPos.X
+	}
+	if newPos.X < 0 {
+		newPos.X = 0
+	}
+	if newPos.Y > maxPos.Y {
+		newPos.Y = maxPos.Y
+	}
+	if newPos.Y < 0 {
+		newPos.Y = 0
+	}
+
+	oldPos := me.layout.offset
+	if newPos == oldPos {
+		return me
+	}
+	me.layout.offset = newPos
+
+	var si win.SCROLLINFO
+	si.SetCbSize()
+	si.FMask = co.SIF_POS
+	si.NPos = newPos.X
+	me.hWnd.SetScrollInfo(co.SB_TYPE_HORZ, &si, true)
+	si.NPos = newPos.Y
+	me.hWnd.SetScrollInfo(co.SB_TYPE_VERT, &si, true)
+
+	me.hWnd.ScrollWindowEx(int(oldPos.X-newPos.X), int(oldPos.Y-newPos.Y),
+		nil, nil, win.HRGN(0), nil,
+		co.SCROLLW_SCROLLCHILDREN|co.SCROLLW_INVALIDATE|co.SCROLLW_ERASE)
+	return me
+}
+
+// Sets the size of the virtual client area, updating the scroll bars. If a
+// dimension is smaller than the visible area, there's no scrolling in that
+// direction.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Panel) SetVirtualSize(cx, cy int) *Panel {
+	me.layout.szVirt = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	if me.hWnd != 0 {
+		me.updateScrollBars()
+	}
+	return me
+}
+
+// This method is analog to [SendMessage] (synchronous), but intended to be
+// called from another thread, so a callback function can, tunelled by
+// [WNDPROC], run in the original thread of the window, thus allowing GUI
+// updates. With this, the user doesn't have to deal with a custom WM_ message.
+//
+// Implements [Parent].
+//
+// [SendMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendmessagew
+// [WNDPROC]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nc-winuser-wndproc
+func (me *Panel) UiThread(fun func()) {
+	me.uiThread(fun)
+}
+
+// Returns the size of the virtual client area.
+func (me *Panel) VirtualSize() (int, int) {
+	return int(me.layout.szVirt.Cx), int(me.layout.szVirt.Cy)
+}
+
+// Implements [Parent].
+func (me *Panel) base() *_BaseContainer {
+	return &me._BaseContainer
+}
+
+// Options for [NewPanel]; returned by [OptsPanel].
+type VarOptsPanel struct {
+	className   string
+	classStyle  co.CS
+	classCursor win.HCURSOR
+	classBrush  win.HBRUSH
+
+	ctrlId      uint16
+	layout      LAY
+	position    win.POINT
+	size        win.SIZE
+	virtualSize win.SIZE
+	lineSize    int
+	style       co.WS
+	exStyle     co.WS_EX
+}
+
+// Options for [NewPanel].
+func OptsPanel() *VarOptsPanel {
+	hCursor, _ := win.HINSTANCE(0).LoadCursor(win.CursorResIdc(co.IDC_ARROW))
+	return &VarOptsPanel{
+		classStyle:  co.CS_DBLCLKS,
+		classCursor: hCursor,
+		classBrush:  win.HBRUSH(co.COLOR_BTNFACE + 1),
+		size:        win.SIZE{Cx: int32(DpiX(300)), Cy: int32(DpiY(200))},
+		lineSize:    DpiY(20),
+		style:       co.WS_CHILD | co.WS_VISIBLE | co.WS_CLIPCHILDREN | co.WS_CLIPSIBLINGS,
+		exStyle:     co.WS_EX_LEFT | co.WS_EX_CONTROLPARENT,
+	}
+}
+
+// Class name registered with [RegisterClassEx].
+//
+// Defaults to a computed hash.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsPanel) ClassName(s string) *VarOptsPanel { o.className = s; return o }
+
+// Window class style, passed to [RegisterClassEx].
+//
+// Defaults to co.CS_DBLCLKS.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsPanel) ClassStyle(s co.CS) *VarOptsPanel { o.classStyle = s; return o }
+
+// Window cursor, passed to [RegisterClassEx].
+//
+// Defaults to stock co.IDC_ARROW.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsPanel) ClassCursor(h win.HCURSOR) *VarOptsPanel { o.classCursor = h; return o }
+
+// Window background brush, passed to [RegisterClassEx].
+//
+// Defaults to co.COLOR_BTNFACE color.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsPanel) ClassBrush(h win.HBRUSH) *VarOptsPanel { o.classBrush = h; return o }
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsPanel) CtrlId(id uint16) *VarOptsPanel { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsPanel) Layout(l LAY) *VarOptsPanel { o.layout = l; return o }
+
+// Position coordinates within parent window client area, passed to
+// [CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsPanel) Position(x, y int) *VarOptsPanel {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Panel visible size in pixels, passed to [CreateWindowEx].
+//
+// Defaults to ui.Dpi(300, 200).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsPanel) Size(cx int, cy int) *VarOptsPanel {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Size of the virtual client area in pixels. If a dimension is smaller than
+// the visible area, there's no scrolling in that direction.
+//
+// Defaults to ui.Dpi(0, 0), which means no scrolling.
+func (o *VarOptsPanel) VirtualSize(cx int, cy int) *VarOptsPanel {
+	o.virtualSize.Cx = int32(cx)
+	o.virtualSize.Cy = int32(cy)
+	return o
+}
+
+// Amount of pixels scrolled when the user clicks the scroll bar arrows, and
+// for each line of the mouse wheel.
+//
+// Defaults to ui.DpiY(20).
+func (o *VarOptsPanel) LineSize(px int) *VarOptsPanel { o.lineSize = px; return o }
+
+// Window style, passed to [CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_CLIPCHILDREN | co.WS_CLIPSIBLINGS.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsPanel) Style(s co.WS) *VarOptsPanel { o.style = s; return o }
+
+// Extended window style, passed to [CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT | co.WS_EX_CONTROLPARENT.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsPanel) ExStyle(s co.WS_EX) *VarOptsPanel { o.exStyle = s; return o }
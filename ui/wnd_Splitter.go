@@ -0,0 +1,633 @@
+//go:build windows
+
+package ui
+
+import (
+	"math"
+	"time"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Orientation of a [Splitter].
+type SPLIT uint8
+
+const (
+	// The panes are placed side by side, separated by a vertical bar, which
+	// moves horizontally.
+	SPLIT_HORZ SPLIT = iota
+	// The panes are placed one above the other, separated by a horizontal bar,
+	// which moves vertically.
+	SPLIT_VERT
+)
+
+// Container which splits its area into two panes, separated by a bar which can
+// be dragged by the user, or moved with the arrow keys after being clicked.
+//
+// Each pane is a single child control, which must be created with the
+// splitter as its parent. To have many controls within a pane, use a
+// [Control] as the pane. The layout option of the panes is ignored, since
+// their position and size are set by the splitter.
+//
+// The splitter paints the focus rectangle on WM_PAINT and sets the resizing
+// cursor on WM_SETCURSOR; these can be overridden with your own handlers.
+//
+// Implements:
+//   - [Window]
+//   - [ChildControl]
+//   - [Parent]
+type Splitter struct {
+	_BaseRaw
+	ctrlId       uint16
+	orientation  SPLIT
+	panes        [2]ChildControl
+	minSizes     [2]int
+	barSize      int
+	keyStep      int
+	liveDrag     bool
+	proportional bool
+	pos          int     // desired bar position; the effective one is clamped to the panes minimum sizes
+	ratio        float64 // desired bar position relative to the client area length, for proportional resizing
+	drag         struct {
+		active     bool
+		grabOffset int // distance from the mouse to the bar start
+		origPos    int // position to be restored if the drag is cancelled
+		trackPos   int // current bar position while dragging
+	}
+}
+
+// Creates a new [Splitter] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	split := ui.NewSplitter(
+//		wndOwner,
+//		ui.OptsSplitter().
+//			Size(ui.Dpi(500, 300)).
+//			SplitPosition(ui.DpiX(150)).
+//			Layout(ui.LAY_RESIZE_RESIZE),
+//	)
+//	tree := ui.NewTreeView(split, ui.OptsTreeView())
+//	list := ui.NewListView(split, ui.OptsListView())
+//	split.SetPanes(tree, list)
+func NewSplitter(parent Parent, opts *VarOptsSplitter) *Splitter {
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &Splitter{
+		_BaseRaw:     newBaseRaw(),
+		ctrlId:       opts.ctrlId,
+		orientation:  opts.orientation,
+		minSizes:     opts.minSizes,
+		barSize:      opts.barSize,
+		keyStep:      opts.keyStep,
+		liveDrag:     opts.liveDrag,
+		proportional: opts.proportional,
+		pos:          opts.splitPos,
+	}
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		hInst, _ := parent.Hwnd().HInstance()
+		atom := me.registerClass(hInst, opts.className, opts.classStyle,
+			0, opts.classBrush, win.HCURSOR(0)) // cursor is set on WM_SETCURSOR
+		me.createWindow(opts.exStyle, atom, "", opts.style,
+			opts.position, opts.size, parent.Hwnd(), win.HMENU(opts.ctrlId), hInst)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+
+		if me.pos < 0 { // no initial position given, split in the middle
+			me.pos = (me.length() - me.barSize) / 2
+		}
+		me.setPos(me.pos) // compute the ratio
+		me.arrange()
+		return 0 // ignored
+	})
+
+	me.defaultMessageHandlers()
+	return me
+}
+
+func (me *Splitter) defaultMessageHandlers() {
+	me.dpiMessageHandlers()
+	me.themeMessageHandlers()
+	me.onDpiChanged(func(oldDpi, newDpi int) {
+		scale := func(v int) int { return int(scaleDpi(int32(v), oldDpi, newDpi)) }
+		if me.pos >= 0 { // negative means not set yet
+			me.pos = scale(me.pos)
+		}
+		me.minSizes = [2]int{scale(me.minSizes[0]), scale(me.minSizes[1])}
+		me.barSize = scale(me.barSize)
+		me.keyStep = scale(me.keyStep)
+	})
+
+	me.beforeUserEvents.WmSize(func(p WmSize) {
+		if p.Request() == co.SIZE_REQ_MINIMIZED {
+			return
+		}
+		if me.proportional { // always derived from the ratio, so rounding errors don't accumulate
+			me.pos = int(math.Round(me.ratio * float64(me.length())))
+		}
+		me.arrange()
+	})
+
+	// Cursor and painting are user events, so they can be overridden.
+	me.userEvents.WmSetCursor(func(p WmSetCursor) bool {
+		if p.HwndUnderCursor() != me.hWnd {
+			return false // let the child control set its own cursor
+		} else if p.HitTest() != co.HT_CLIENT {
+			return me.hWnd.DefWindowProc(co.WM_SETCURSOR, p.Raw.WParam, p.Raw.LParam) != 0
+		}
+
+		pt, _ := win.GetCursorPos()
+		me.hWnd.ScreenToClientPt(&pt)
+		idc := co.IDC_ARROW
+		if me.drag.active || ptInRect(me.barRect(me.Position()), pt) {
+			if me.orientation == SPLIT_HORZ {
+				idc = co.IDC_SIZEWE
+			} else {
+				idc = co.IDC_SIZENS
+			}
+		}
+		hCursor, _ := win.HINSTANCE(0).LoadCursor(win.CursorResIdc(idc))
+		hCursor.SetCursor()
+		return true
+	})
+
+	me.beforeUserEvents.WmLButtonDown(func(p WmMouse) {
+		curPos := me.Position()
+		if !ptInRect(me.barRect(curPos), p.Pos()) {
+			return
+		}
+		me.hWnd.SetFocus() // so the bar can be moved with the keyboard
+		me.hWnd.SetCapture()
+		me.drag.active = true
+		me.drag.grabOffset = me.coord(p.Pos()) - curPos
+		me.drag.origPos = me.pos
+		me.drag.trackPos = curPos
+		if !me.liveDrag {
+			me.invertTracker()
+		}
+	})
+
+	me.beforeUserEvents.WmMouseMove(func(p WmMouse) {
+		if !me.drag.active {
+			return
+		}
+		newPos := me.clampPos(me.coord(p.Pos()) - me.drag.grabOffset)
+		if newPos == me.drag.trackPos {
+			return
+		}
+		if me.liveDrag {
+			me.drag.trackPos = newPos
+			me.setPos(newPos)
+			me.arrange()
+			me.hWnd.UpdateWindow()
+		} else {
+			me.invertTracker() // erase at old position
+			me.drag.trackPos = newPos
+			me.invertTracker()
+		}
+	})
+
+	me.beforeUserEvents.WmLButtonUp(func(_ WmMouse) {
+		me.endDrag(true)
+	})
+
+	me.beforeUserEvents.WmCaptureChanged(func(_ WmCaptureChanged) {
+		me.endDrag(false) // capture lost while dragging, like Alt+Tab
+	})
+
+	// A fallback event, since its return value reaches the dialog manager,
+	// which otherwise turns the arrows into group navigation and Esc into
+	// IDCANCEL.
+	me.fallbackEvents.WmGetDlgCode(func(_ WmGetDlgCode) co.DLGC {
+		if me.drag.active {
+			return co.DLGC_WANTARROWS | co.DLGC_WANTMESSAGE // Esc cancels the drag
+		}
+		return co.DLGC_WANTARROWS
+	})
+
+	me.beforeUserEvents.WmKeyDown(func(p WmKey) {
+		vkLess, vkMore := co.VK_LEFT, co.VK_RIGHT
+		if me.orientation == SPLIT_VERT {
+			vkLess, vkMore = co.VK_UP, co.VK_DOWN
+		}
+
+		switch p.VirtualKeyCode() {
+		case co.VK_ESCAPE:
+			me.endDrag(false)
+		case vkLess:
+			if !me.drag.active {
+				me.SetPosition(me.Position() - me.keyStep)
+			}
+		case vkMore:
+			if !me.drag.active {
+				me.SetPosition(me.Position() + me.keyStep)
+			}
+		case co.VK_HOME:
+			if !me.drag.active {
+				me.SetPosition(0) // will be clamped to first pane minimum size
+			}
+		case co.VK_END:
+			if !me.drag.active {
+				me.SetPosition(me.length()) // will be clamped to second pane minimum size
+			}
+		}
+	})
+
+	me.beforeUserEvents.WmSetFocus(func(_ WmSetFocus) {
+		me.hWnd.InvalidateRect(nil, true) // paint focus rect
+	})
+
+	me.beforeUserEvents.WmKillFocus(func(_ WmKillFocus) {
+		me.hWnd.InvalidateRect(nil, true) // remove focus rect
+	})
+
+	me.userEvents.WmPaint(func() {
+		var ps win.PAINTSTRUCT
+		hdc, _ := me.hWnd.BeginPaint(&ps)
+		defer me.hWnd.EndPaint(&ps)
+
+		if win.GetFocus() == me.hWnd {
+			rcBar := me.barRect(me.Position())
+			hdc.DrawFocusRect(&rcBar)
+		}
+	})
+
+	me.userEvents.WmNcPaint(func(p WmNcPaint) {
+		paintThemedBorders(me.hWnd, p)
+	})
+}
+
+// Returns the length of the client area along the splitting axis.
+func (me *Splitter) length() int {
+	rc, _ := me.hWnd.GetClientRect()
+	if me.orientation == SPLIT_HORZ {
+		return int(rc.Right)
+	}
+	return int(rc.Bottom)
+}
+
+// Sets the desired bar position, also updating the ratio used for proportional
+// resizing.
+func (me *Splitter) setPos(pos int) {
+	me.pos = pos
+	if length := me.length(); length > 0 {
+		me.ratio = float64(pos) / float64(length)
+	}
+}
+
+// Returns the coordinate of the point along the splitting axis.
+func (me *Splitter) coord(pt win.POINT) int {
+	if me.orientation == SPLIT_HORZ {
+		return int(pt.X)
+	}
+	return int(pt.Y)
+}
+
+// Clamps the bar position to the minimum sizes of the panes. If the area is too
+// small for both, the first pane has precedence.
+func (me *Splitter) clampPos(pos int) int {
+	if maxPos := me.length() - me.barSize - me.minSizes[1]; pos > maxPos {
+		pos = maxPos
+	}
+	if pos < me.minSizes[0] {
+		pos = me.minSizes[0]
+	}
+	return pos
+}
+
+// Returns the rectangle of the bar at the given position.
+func (me *Splitter) barRect(pos int) win.RECT {
+	rc, _ := me.hWnd.GetClientRect()
+	if me.orientation == SPLIT_HORZ {
+		rc.Left = int32(pos)
+		rc.Right = rc.Left + int32(me.barSize)
+	} else {
+		rc.Top = int32(pos)
+		rc.Bottom = rc.Top + int32(me.barSize)
+	}
+	return rc
+}
+
+// Positions the panes around the bar.
+func (me *Splitter) arrange() {
+	rcBar := me.barRect(me.Position())
+	rcClient, _ := me.hWnd.GetClientRect()
+	rcPanes := [2]win.RECT{rcClient, rcClient}
+	if me.orientation == SPLIT_HORZ {
+		rcPanes[0].Right = rcBar.Left
+		rcPanes[1].Left = rcBar.Right
+	} else {
+		rcPanes[0].Bottom = rcBar.Top
+		rcPanes[1].Top = rcBar.Bottom
+	}
+
+	for i, pane := range me.panes {
+		if pane == nil || pane.Hwnd() == 0 {
+			continue
+		}
+		rc := rcPanes[i]
+		if rc.Right < rc.Left {
+			rc.Right = rc.Left
+		}
+		if rc.Bottom < rc.Top {
+			rc.Bottom = rc.Top
+		}
+		pane.Hwnd().SetWindowPos(win.HWND(0), int(rc.Left), int(rc.Top),
+			uint(rc.Right-rc.Left), uint(rc.Bottom-rc.Top),
+			co.SWP_NOZORDER|co.SWP_NOACTIVATE)
+	}
+
+	me.hWnd.InvalidateRect(nil, true) // repaint the bar
+}
+
+// Draws or erases the tracking bar, when not in live drag mode. Since the
+// drawing is an inversion, calling it twice restores the original pixels.
+func (me *Splitter) invertTracker() {
+	hdc, err := me.hWnd.GetDCEx(win.HRGN(0), co.DCX_CACHE) // not clipping children, so the tracker is drawn over the panes
+	if err != nil {
+		return
+	}
+	defer me.hWnd.ReleaseDC(hdc)
+
+	rc := me.barRect(me.drag.trackPos)
+	hdc.InvertRect(&rc)
+}
+
+// Finishes a drag operation, if any, either committing or restoring the
+// original bar position.
+func (me *Splitter) endDrag(commit bool) {
+	if !me.drag.active {
+		return
+	}
+	me.drag.active = false // before ReleaseCapture, which sends WM_CAPTURECHANGED
+
+	if !me.liveDrag {
+		me.invertTracker() // erase
+	}
+	if commit {
+		me.setPos(me.drag.trackPos)
+	} else {
+		me.setPos(me.drag.origPos)
+	}
+	if win.GetCapture() == me.hWnd {
+		win.ReleaseCapture()
+	}
+	me.arrange()
+}
+
+// Returns the control ID, unique within the same Parent.
+//
+// Implements [ChildControl].
+func (me *Splitter) CtrlId() uint16 {
+	return me.ctrlId
+}
+
+// Calls [win.HWND.SetFocus].
+//
+// Implements [ChildControl].
+func (me *Splitter) Focus() {
+	me.hWnd.SetFocus()
+}
+
+// Creates a [Timer] which calls fun once, in the UI thread, after the given
+// duration.
+//
+// Implements [Parent].
+func (me *Splitter) AfterFunc(d time.Duration, fun func()) *Timer {
+	return me.base().newTimer(d, false, fun)
+}
+
+// Creates a [Timer] which calls fun repeatedly, in the UI thread, at the given
+// interval, until it's stopped.
+//
+// Implements [Parent].
+func (me *Splitter) Every(interval time.Duration, fun func()) *Timer {
+	return me.base().newTimer(interval, true, fun)
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Implements [Window].
+//
+// Note that this handle is initially zero, existing only after window creation.
+func (me *Splitter) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Exposes all the window notifications the can be handled.
+//
+// Implements [Parent].
+//
+// Panics if called after the window has been created.
+func (me *Splitter) On() *EventsWindow {
+	if me.hWnd != 0 {
+		panic("Cannot add event handling after the window has been created.")
+	}
+	return &me.userEvents
+}
+
+// Returns the current bar position, in pixels, which is the size of the first
+// pane. This value can be persisted and later restored with
+// [Splitter.SetPosition].
+func (me *Splitter) Position() int {
+	if me.hWnd == 0 {
+		return me.pos
+	}
+	return me.clampPos(me.pos)
+}
+
+// Sets the controls of the first and second panes, which must have been
+// created with the splitter as their parent. A nil pane leaves its area empty.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Splitter) SetPanes(first, second ChildControl) *Splitter {
+	me.panes = [2]ChildControl{first, second}
+	if me.hWnd != 0 {
+		me.arrange()
+	}
+	return me
+}
+
+// Sets the bar position, in pixels, which is the size of the first pane. The
+// position is clamped to the minimum sizes of the panes.
+//
+// Returns the same object, so further operations can be chained.
+func (me *Splitter) SetPosition(pos int) *Splitter {
+	if me.hWnd == 0 {
+		me.pos = pos
+	} else {
+		me.setPos(me.clampPos(pos))
+		me.arrange()
+	}
+	return me
+}
+
+// This method is analog to [SendMessage] (synchronous), but intended to be
+// called from another thread, so a callback function can, tunelled by
+// [WNDPROC], run in the original thread of the window, thus allowing GUI
+// updates. With this, the user doesn't have to deal with a custom WM_ message.
+//
+// Implements [Parent].
+//
+// [SendMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendmessagew
+// [WNDPROC]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nc-winuser-wndproc
+func (me *Splitter) UiThread(fun func()) {
+	me.uiThread(fun)
+}
+
+// Implements [Parent].
+func (me *Splitter) base() *_BaseContainer {
+	return &me._BaseContainer
+}
+
+// Options for [NewSplitter]; returned by [OptsSplitter].
+type VarOptsSplitter struct {
+	className  string
+	classStyle co.CS
+	classBrush win.HBRUSH
+
+	ctrlId       uint16
+	layout       LAY
+	position     win.POINT
+	size         win.SIZE
+	orientation  SPLIT
+	splitPos     int
+	barSize      int
+	minSizes     [2]int
+	keyStep      int
+	liveDrag     bool
+	proportional bool
+	style        co.WS
+	exStyle      co.WS_EX
+}
+
+// Options for [NewSplitter].
+func OptsSplitter() *VarOptsSplitter {
+	return &VarOptsSplitter{
+		classStyle:  co.CS_DBLCLKS,
+		classBrush:  win.HBRUSH(co.COLOR_BTNFACE + 1),
+		size:        win.SIZE{Cx: int32(DpiX(300)), Cy: int32(DpiY(200))},
+		orientation: SPLIT_HORZ,
+		splitPos:    -1,
+		barSize:     DpiX(5),
+		minSizes:    [2]int{DpiX(20), DpiX(20)},
+		keyStep:     DpiX(10),
+		liveDrag:    true,
+		style:       co.WS_CHILD | co.WS_VISIBLE | co.WS_CLIPCHILDREN | co.WS_CLIPSIBLINGS,
+		exStyle:     co.WS_EX_LEFT | co.WS_EX_CONTROLPARENT,
+	}
+}
+
+// Class name registered with [RegisterClassEx].
+//
+// Defaults to a computed hash.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsSplitter) ClassName(s string) *VarOptsSplitter { o.className = s; return o }
+
+// Window class style, passed to [RegisterClassEx].
+//
+// Defaults to co.CS_DBLCLKS.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsSplitter) ClassStyle(s co.CS) *VarOptsSplitter { o.classStyle = s; return o }
+
+// Window background brush, passed to [RegisterClassEx], which is the color of
+// the bar.
+//
+// Defaults to co.COLOR_BTNFACE color.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsSplitter) ClassBrush(h win.HBRUSH) *VarOptsSplitter { o.classBrush = h; return o }
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsSplitter) CtrlId(id uint16) *VarOptsSplitter { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsSplitter) Layout(l LAY) *VarOptsSplitter { o.layout = l; return o }
+
+// Position coordinates within parent window client area, passed to
+// [CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsSplitter) Position(x, y int) *VarOptsSplitter {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control size in pixels, passed to [CreateWindowEx].
+//
+// Defaults to ui.Dpi(300, 200).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsSplitter) Size(cx int, cy int) *VarOptsSplitter {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Orientation of the panes.
+//
+// Defaults to ui.SPLIT_HORZ.
+func (o *VarOptsSplitter) Orientation(s SPLIT) *VarOptsSplitter { o.orientation = s; return o }
+
+// Initial bar position in pixels, which is the size of the first pane.
+//
+// Defaults to the middle of the splitter.
+func (o *VarOptsSplitter) SplitPosition(pos int) *VarOptsSplitter { o.splitPos = pos; return o }
+
+// Thickness of the bar in pixels.
+//
+// Defaults to ui.DpiX(5).
+func (o *VarOptsSplitter) BarSize(px int) *VarOptsSplitter { o.barSize = px; return o }
+
+// Minimum sizes of the first and second panes, in pixels, along the splitting
+// axis.
+//
+// Defaults to ui.DpiX(20) for both.
+func (o *VarOptsSplitter) MinSizes(first, second int) *VarOptsSplitter {
+	o.minSizes = [2]int{first, second}
+	return o
+}
+
+// Amount of pixels the bar is moved by each arrow key press.
+//
+// Defaults to ui.DpiX(10).
+func (o *VarOptsSplitter) KeyStep(px int) *VarOptsSplitter { o.keyStep = px; return o }
+
+// If true, the panes are resized while the bar is dragged; otherwise, an
+// inverted tracking bar is drawn, and the panes are resized when the mouse
+// button is released.
+//
+// Defaults to true.
+func (o *VarOptsSplitter) LiveDrag(b bool) *VarOptsSplitter { o.liveDrag = b; return o }
+
+// If true, when the splitter is resized, both panes keep their proportion;
+// otherwise, the first pane keeps its size.
+//
+// Defaults to false.
+func (o *VarOptsSplitter) Proportional(b bool) *VarOptsSplitter { o.proportional = b; return o }
+
+// Window style, passed to [CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_CLIPCHILDREN | co.WS_CLIPSIBLINGS.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsSplitter) Style(s co.WS) *VarOptsSplitter { o.style = s; return o }
+
+// Extended window style, passed to [CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT | co.WS_EX_CONTROLPARENT.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsSplitter) ExStyle(s co.WS_EX) *VarOptsSplitter { o.exStyle = s; return o }
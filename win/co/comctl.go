@@ -32,6 +32,25 @@ const (
 	BTNS_WHOLEDROPDOWN BTNS = 0x0080
 )
 
+// Common control [styles], used by toolbars, rebars and status bars.
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/common-control-styles
+type CCS WS
+
+const (
+	CCS_TOP           CCS = 0x0001
+	CCS_NOMOVEY       CCS = 0x0002
+	CCS_BOTTOM        CCS = 0x0003
+	CCS_NORESIZE      CCS = 0x0004
+	CCS_NOPARENTALIGN CCS = 0x0008
+	CCS_ADJUSTABLE    CCS = 0x0020
+	CCS_NODIVIDER     CCS = 0x0040
+	CCS_VERT          CCS = 0x0080
+	CCS_LEFT              = CCS_VERT | CCS_TOP
+	CCS_RIGHT             = CCS_VERT | CCS_BOTTOM
+	CCS_NOMOVEX           = CCS_VERT | CCS_NOMOVEY
+)
+
 // [NMCUSTOMDRAW] dwDrawStage.
 //
 // [NMCUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmcustomdraw
@@ -253,6 +272,22 @@ const (
 	HICF_TOGGLEDROPDOWN HICF = 0x0000_0100
 )
 
+// [HKM_SETRULES] invalid key combinations.
+//
+// [HKM_SETRULES]: https://learn.microsoft.com/en-us/windows/win32/controls/hkm-setrules
+type HKCOMB uint16
+
+const (
+	HKCOMB_NONE HKCOMB = 0x0001 // Unmodified keys.
+	HKCOMB_S    HKCOMB = 0x0002 // SHIFT.
+	HKCOMB_C    HKCOMB = 0x0004 // CTRL.
+	HKCOMB_A    HKCOMB = 0x0008 // ALT.
+	HKCOMB_SC   HKCOMB = 0x0010 // SHIFT+CTRL.
+	HKCOMB_SA   HKCOMB = 0x0020 // SHIFT+ALT.
+	HKCOMB_CA   HKCOMB = 0x0040 // CTRL+ALT.
+	HKCOMB_SCA  HKCOMB = 0x0080 // SHIFT+CTRL+ALT.
+)
+
 // [INITCOMMONCONTROLSEX] icc.
 //
 // [INITCOMMONCONTROLSEX]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-initcommoncontrolsex
@@ -654,6 +689,17 @@ const (
 	LVS_EX_UNDERLINEHOT          LVS_EX = 0x0000_0800
 )
 
+// [LVM_SETITEMCOUNT] flags.
+//
+// [LVM_SETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setitemcount
+type LVSICF uint32
+
+const (
+	LVSICF_NONE            LVSICF = 0
+	LVSICF_NOINVALIDATEALL LVSICF = 0x0000_0001
+	LVSICF_NOSCROLL        LVSICF = 0x0000_0002
+)
+
 // [LVM_GETIMAGELIST] and [LVM_SETIMAGELIST] type.
 //
 // [LVM_GETIMAGELIST]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getimagelist
@@ -733,6 +779,65 @@ const (
 	PBST_PAUSED PBST = 0x0003
 )
 
+// [REBARBANDINFO] fMask.
+//
+// [REBARBANDINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-rebarbandinfow
+type RBBIM uint32
+
+const (
+	RBBIM_STYLE           RBBIM = 0x0000_0001
+	RBBIM_COLORS          RBBIM = 0x0000_0002
+	RBBIM_TEXT            RBBIM = 0x0000_0004
+	RBBIM_IMAGE           RBBIM = 0x0000_0008
+	RBBIM_CHILD           RBBIM = 0x0000_0010
+	RBBIM_CHILDSIZE       RBBIM = 0x0000_0020
+	RBBIM_SIZE            RBBIM = 0x0000_0040
+	RBBIM_BACKGROUND      RBBIM = 0x0000_0080
+	RBBIM_ID              RBBIM = 0x0000_0100
+	RBBIM_IDEALSIZE       RBBIM = 0x0000_0200
+	RBBIM_LPARAM          RBBIM = 0x0000_0400
+	RBBIM_HEADERSIZE      RBBIM = 0x0000_0800
+	RBBIM_CHEVRONLOCATION RBBIM = 0x0000_1000
+	RBBIM_CHEVRONSTATE    RBBIM = 0x0000_2000
+)
+
+// [REBARBANDINFO] fStyle.
+//
+// [REBARBANDINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-rebarbandinfow
+type RBBS uint32
+
+const (
+	RBBS_NONE           RBBS = 0
+	RBBS_BREAK          RBBS = 0x0000_0001
+	RBBS_FIXEDSIZE      RBBS = 0x0000_0002
+	RBBS_CHILDEDGE      RBBS = 0x0000_0004
+	RBBS_HIDDEN         RBBS = 0x0000_0008
+	RBBS_NOVERT         RBBS = 0x0000_0010
+	RBBS_FIXEDBMP       RBBS = 0x0000_0020
+	RBBS_VARIABLEHEIGHT RBBS = 0x0000_0040
+	RBBS_GRIPPERALWAYS  RBBS = 0x0000_0080
+	RBBS_NOGRIPPER      RBBS = 0x0000_0100
+	RBBS_USECHEVRON     RBBS = 0x0000_0200
+	RBBS_HIDETITLE      RBBS = 0x0000_0400
+	RBBS_TOPALIGN       RBBS = 0x0000_0800
+)
+
+// Rebar control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/rebar-control-styles
+type RBS WS
+
+const (
+	RBS_TOOLTIPS        RBS = 0x0000_0100
+	RBS_VARHEIGHT       RBS = 0x0000_0200
+	RBS_BANDBORDERS     RBS = 0x0000_0400
+	RBS_FIXEDORDER      RBS = 0x0000_0800
+	RBS_REGISTERDROP    RBS = 0x0000_1000
+	RBS_AUTOSIZE        RBS = 0x0000_2000
+	RBS_VERTICALGRIPPER RBS = 0x0000_4000
+	RBS_DBLCLKTOGGLE    RBS = 0x0000_8000
+)
+
 // StatusBar [styles].
 //
 // [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/status-bar-styles
@@ -988,6 +1093,50 @@ const (
 	TTI_ERROR_LARGE   TTI = 6
 )
 
+// [TTM_SETDELAYTIME] duration.
+//
+// [TTM_SETDELAYTIME]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setdelaytime
+type TTDT uint32
+
+const (
+	TTDT_AUTOMATIC TTDT = 0
+	TTDT_RESHOW    TTDT = 1
+	TTDT_AUTOPOP   TTDT = 2
+	TTDT_INITIAL   TTDT = 3
+)
+
+// [TTTOOLINFO] uFlags.
+//
+// [TTTOOLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tttoolinfow
+type TTF uint32
+
+const (
+	TTF_IDISHWND    TTF = 0x0001
+	TTF_CENTERTIP   TTF = 0x0002
+	TTF_RTLREADING  TTF = 0x0004
+	TTF_SUBCLASS    TTF = 0x0010
+	TTF_TRACK       TTF = 0x0020
+	TTF_ABSOLUTE    TTF = 0x0080
+	TTF_TRANSPARENT TTF = 0x0100
+	TTF_PARSELINKS  TTF = 0x1000
+	TTF_DI_SETITEM  TTF = 0x8000
+)
+
+// Tooltip control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-styles
+type TTS WS
+
+const (
+	TTS_ALWAYSTIP      TTS = 0x01
+	TTS_NOPREFIX       TTS = 0x02
+	TTS_NOANIMATE      TTS = 0x10
+	TTS_NOFADE         TTS = 0x20
+	TTS_BALLOON        TTS = 0x40
+	TTS_CLOSE          TTS = 0x80
+	TTS_USEVISUALSTYLE TTS = 0x100
+)
+
 // [TVM_EXPAND] action flag.
 //
 // [TVM_EXPAND]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-expand
@@ -1021,6 +1170,26 @@ const (
 	TVGN_NEXTSELECTED    TVGN = 0x000b
 )
 
+// [TVHITTESTINFO] flags.
+//
+// [TVHITTESTINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tvhittestinfo
+type TVHT uint32
+
+const (
+	TVHT_NOWHERE         TVHT = 0x0001
+	TVHT_ONITEMICON      TVHT = 0x0002
+	TVHT_ONITEMLABEL     TVHT = 0x0004
+	TVHT_ONITEM               = TVHT_ONITEMICON | TVHT_ONITEMLABEL | TVHT_ONITEMSTATEICON
+	TVHT_ONITEMINDENT    TVHT = 0x0008
+	TVHT_ONITEMBUTTON    TVHT = 0x0010
+	TVHT_ONITEMRIGHT     TVHT = 0x0020
+	TVHT_ONITEMSTATEICON TVHT = 0x0040
+	TVHT_ABOVE           TVHT = 0x0100
+	TVHT_BELOW           TVHT = 0x0200
+	TVHT_TORIGHT         TVHT = 0x0400
+	TVHT_TOLEFT          TVHT = 0x0800
+)
+
 // [TVITEMTEX] cChildren.
 //
 // [TVITEMTEX]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tvitemexw
@@ -143,6 +143,17 @@ const (
 	CREATE_INHERIT_PARENT_AFFINITY      CREATE = 0x0001_0000
 )
 
+// [CreateWaitableTimerEx] dwFlags.
+//
+// [CreateWaitableTimerEx]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createwaitabletimerexw
+type CREATE_WAITABLE_TIMER uint32
+
+const (
+	CREATE_WAITABLE_TIMER_NONE            CREATE_WAITABLE_TIMER = 0
+	CREATE_WAITABLE_TIMER_MANUAL_RESET    CREATE_WAITABLE_TIMER = 0x0000_0001
+	CREATE_WAITABLE_TIMER_HIGH_RESOLUTION CREATE_WAITABLE_TIMER = 0x0000_0002
+)
+
 // [WM_DEVICECHANGE] event.
 //
 // [WM_DEVICECHANGE]: https://learn.microsoft.com/en-us/windows/win32/devio/wm-devicechange
@@ -1265,6 +1276,17 @@ const (
 	THREAD_PRIORITY_IDLE                          = _THREAD_BASE_PRIORITY_IDLE
 )
 
+// Waitable timer [access rights].
+//
+// [access rights]: https://learn.microsoft.com/en-us/windows/win32/sync/synchronization-object-security-and-access-rights
+type TIMER uint32
+
+const (
+	TIMER_QUERY_STATE  TIMER = 0x0001
+	TIMER_MODIFY_STATE TIMER = 0x0002
+	TIMER_ALL_ACCESS   TIMER = TIMER(STANDARD_RIGHTS_REQUIRED|STANDARD_RIGHTS_SYNCHRONIZE) | TIMER_QUERY_STATE | TIMER_MODIFY_STATE
+)
+
 // [GetTimeZoneInformation] return value.
 //
 // [GetTimeZoneInformation]: https://learn.microsoft.com/en-us/windows/win32/api/timezoneapi/nf-timezoneapi-gettimezoneinformation
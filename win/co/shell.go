@@ -345,6 +345,23 @@ const (
 	NIM_SETVERSION NIM = 0x0000_0004
 )
 
+// [Shell_NotifyIcon] notifications, sent in the LOWORD of the callback message
+// LPARAM, when the icon is set to [NOTIFYICON_VERSION_4].
+//
+// [Shell_NotifyIcon]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+type NIN uint32
+
+const (
+	NIN_SELECT           NIN = 0x0400 // WM_USER + 0
+	NIN_KEYSELECT        NIN = 0x0401 // NIN_SELECT | NINF_KEY
+	NIN_BALLOONSHOW      NIN = 0x0402
+	NIN_BALLOONHIDE      NIN = 0x0403
+	NIN_BALLOONTIMEOUT   NIN = 0x0404
+	NIN_BALLOONUSERCLICK NIN = 0x0405
+	NIN_POPUPOPEN        NIN = 0x0406
+	NIN_POPUPCLOSE       NIN = 0x0407
+)
+
 // [NOTIFYICONDATA] dwState.
 //
 // [NOTIFYICONDATA]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-notifyicondataw
@@ -355,6 +372,17 @@ const (
 	NIS_SHAREDICON NIS = 0x0000_0002
 )
 
+// [NOTIFYICONDATA] uVersion.
+//
+// [NOTIFYICONDATA]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-notifyicondataw
+type NOTIFYICON_VERSION uint32
+
+const (
+	NOTIFYICON_VERSION_0 NOTIFYICON_VERSION = 0
+	NOTIFYICON_VERSION_3 NOTIFYICON_VERSION = 3
+	NOTIFYICON_VERSION_4 NOTIFYICON_VERSION = 4
+)
+
 // [SHFILEINFO] dwAttributes.
 //
 // [SHFILEINFO]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shfileinfow
@@ -372,6 +372,42 @@ const (
 	DLGC_BUTTON          DLGC = 0x2000
 )
 
+// [DPI_AWARENESS_CONTEXT] pseudo-handles.
+//
+// [DPI_AWARENESS_CONTEXT]: https://learn.microsoft.com/en-us/windows/win32/hidpi/dpi-awareness-context
+type DPI_AWARENESS_CONTEXT int32
+
+const (
+	DPI_AWARENESS_CONTEXT_UNAWARE              DPI_AWARENESS_CONTEXT = -1
+	DPI_AWARENESS_CONTEXT_SYSTEM_AWARE         DPI_AWARENESS_CONTEXT = -2
+	DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE    DPI_AWARENESS_CONTEXT = -3
+	DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2 DPI_AWARENESS_CONTEXT = -4
+	DPI_AWARENESS_CONTEXT_UNAWARE_GDISCALED    DPI_AWARENESS_CONTEXT = -5
+)
+
+// Dialog box [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dialog-box-styles
+type DS WS
+
+const (
+	DS_ABSALIGN      DS = 0x0000_0001
+	DS_SYSMODAL      DS = 0x0000_0002
+	DS_3DLOOK        DS = 0x0000_0004
+	DS_FIXEDSYS      DS = 0x0000_0008
+	DS_NOFAILCREATE  DS = 0x0000_0010
+	DS_LOCALEDIT     DS = 0x0000_0020
+	DS_SETFONT       DS = 0x0000_0040
+	DS_MODALFRAME    DS = 0x0000_0080
+	DS_NOIDLEMSG     DS = 0x0000_0100
+	DS_SETFOREGROUND DS = 0x0000_0200
+	DS_CONTROL       DS = 0x0000_0400
+	DS_CENTER        DS = 0x0000_0800
+	DS_CENTERMOUSE   DS = 0x0000_1000
+	DS_CONTEXTHELP   DS = 0x0000_2000
+	DS_SHELLFONT     DS = DS_SETFONT | DS_FIXEDSYS
+)
+
 // [EnumDisplayDevices] flags.
 //
 // [EnumDisplayDevices]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enumdisplaydevicesw
@@ -402,6 +438,12 @@ const (
 	ES_READONLY    ES = 0x0800
 	ES_WANTRETURN  ES = 0x1000
 	ES_NUMBER      ES = 0x2000
+
+	ES_NOOLEDRAGDROP   ES = 0x0008      // RichEdit only.
+	ES_DISABLENOSCROLL ES = 0x2000      // RichEdit only.
+	ES_SUNKEN          ES = 0x4000      // RichEdit only.
+	ES_SAVESEL         ES = 0x8000      // RichEdit only.
+	ES_SELECTIONBAR    ES = 0x0100_0000 // RichEdit only.
 )
 
 // [ExitWindowsEx] flags.
@@ -478,6 +520,16 @@ const (
 	GCL_WNDPROC       GCL = -24
 )
 
+// [WM_INPUT_DEVICE_CHANGE] wParam.
+//
+// [WM_INPUT_DEVICE_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-input-device-change
+type GIDC uint32
+
+const (
+	GIDC_ARRIVAL GIDC = 1
+	GIDC_REMOVAL GIDC = 2
+)
+
 // [GUITHREADINFO] flags.
 //
 // [GUITHREADINFO]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-guithreadinfo
@@ -516,6 +568,38 @@ const (
 	GW_ENABLEDPOPUP GW = 6
 )
 
+// [SetWindowsHookEx] hook procedure codes.
+//
+// [SetWindowsHookEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowshookexw
+type HC int32
+
+const (
+	HC_ACTION      HC = 0
+	HC_GETNEXT     HC = 1
+	HC_SKIP        HC = 2
+	HC_NOREMOVE    HC = 3
+	HC_SYSMODALON  HC = 4
+	HC_SYSMODALOFF HC = 5
+)
+
+// [CBTProc] nCode.
+//
+// [CBTProc]: https://learn.microsoft.com/en-us/windows/win32/winmsg/cbtproc
+type HCBT int32
+
+const (
+	HCBT_MOVESIZE     HCBT = 0
+	HCBT_MINMAX       HCBT = 1
+	HCBT_QS           HCBT = 2
+	HCBT_CREATEWND    HCBT = 3
+	HCBT_DESTROYWND   HCBT = 4
+	HCBT_ACTIVATE     HCBT = 5
+	HCBT_CLICKSKIPPED HCBT = 6
+	HCBT_KEYSKIPPED   HCBT = 7
+	HCBT_SYSCOMMAND   HCBT = 8
+	HCBT_SETFOCUS     HCBT = 9
+)
+
 // [HELPINFO] iContextType.
 //
 // [HELPINFO]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-helpinfo
@@ -658,6 +742,17 @@ const (
 	IMAGE_ENHMETAFILE IMAGE = 3
 )
 
+// [INPUT] type.
+//
+// [INPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input
+type INPUT uint32
+
+const (
+	INPUT_MOUSE    INPUT = 0
+	INPUT_KEYBOARD INPUT = 1
+	INPUT_HARDWARE INPUT = 2
+)
+
 // [InSendMessageEx] return value.
 //
 // [InSendMessageEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-insendmessageex
@@ -671,6 +766,18 @@ const (
 	ISMEX_SEND     ISMEX = 0x0000_0001
 )
 
+// [KEYBDINPUT] dwFlags.
+//
+// [KEYBDINPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-keybdinput
+type KEYEVENTF uint32
+
+const (
+	KEYEVENTF_EXTENDEDKEY KEYEVENTF = 0x0001
+	KEYEVENTF_KEYUP       KEYEVENTF = 0x0002
+	KEYEVENTF_UNICODE     KEYEVENTF = 0x0004
+	KEYEVENTF_SCANCODE    KEYEVENTF = 0x0008
+)
+
 // [SetProcessDefaultLayout] dwDefaultLayout.
 //
 // [SetProcessDefaultLayout]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setprocessdefaultlayout
@@ -681,6 +788,54 @@ const (
 	LAYOUT_RTL    LAYOUT = 0x0000_0001
 )
 
+// ListBox control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/list-box-styles
+type LBS WS
+
+const (
+	LBS_NOTIFY            LBS = 0x0001
+	LBS_SORT              LBS = 0x0002
+	LBS_NOREDRAW          LBS = 0x0004
+	LBS_MULTIPLESEL       LBS = 0x0008
+	LBS_OWNERDRAWFIXED    LBS = 0x0010
+	LBS_OWNERDRAWVARIABLE LBS = 0x0020
+	LBS_HASSTRINGS        LBS = 0x0040
+	LBS_USETABSTOPS       LBS = 0x0080
+	LBS_NOINTEGRALHEIGHT  LBS = 0x0100
+	LBS_MULTICOLUMN       LBS = 0x0200
+	LBS_WANTKEYBOARDINPUT LBS = 0x0400
+	LBS_EXTENDEDSEL       LBS = 0x0800
+	LBS_DISABLENOSCROLL   LBS = 0x1000
+	LBS_NODATA            LBS = 0x2000
+	LBS_NOSEL             LBS = 0x4000
+	LBS_COMBOBOX          LBS = 0x8000
+	LBS_STANDARD              = LBS_NOTIFY | LBS_SORT | LBS(WS_VSCROLL) | LBS(WS_BORDER)
+)
+
+// [KBDLLHOOKSTRUCT] flags.
+//
+// [KBDLLHOOKSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-kbdllhookstruct
+type LLKHF uint32
+
+const (
+	LLKHF_EXTENDED          LLKHF = 0x0000_0001
+	LLKHF_LOWER_IL_INJECTED LLKHF = 0x0000_0002
+	LLKHF_INJECTED          LLKHF = 0x0000_0010
+	LLKHF_ALTDOWN           LLKHF = 0x0000_0020
+	LLKHF_UP                LLKHF = 0x0000_0080
+)
+
+// [MSLLHOOKSTRUCT] flags.
+//
+// [MSLLHOOKSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-msllhookstruct
+type LLMHF uint32
+
+const (
+	LLMHF_INJECTED          LLMHF = 0x0000_0001
+	LLMHF_LOWER_IL_INJECTED LLMHF = 0x0000_0002
+)
+
 // [LoadImage] fuLoad.
 //
 // [LoadImage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadimagew
@@ -712,6 +867,19 @@ const (
 	LSFW_UNLOCK LSFW = 2
 )
 
+// [MapVirtualKey] mapType.
+//
+// [MapVirtualKey]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-mapvirtualkeyw
+type MAPVK uint32
+
+const (
+	MAPVK_VK_TO_VSC    MAPVK = 0
+	MAPVK_VSC_TO_VK    MAPVK = 1
+	MAPVK_VK_TO_CHAR   MAPVK = 2
+	MAPVK_VSC_TO_VK_EX MAPVK = 3
+	MAPVK_VK_TO_VSC_EX MAPVK = 4
+)
+
 // [MessageBox] uType.
 //
 // [MessageBox]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-messageboxw
@@ -933,16 +1101,18 @@ const (
 	MNS_CHECKORBMP  MNS = 0x0400_0000
 )
 
-// [WM_HOTKEY] combined keys.
+// [WM_HOTKEY] combined keys, and [RegisterHotKey] fsModifiers.
 //
 // [WM_HOTKEY]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-hotkey
+// [RegisterHotKey]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerhotkey
 type MOD uint16
 
 const (
-	MOD_ALT     MOD = 0x0001
-	MOD_CONTROL MOD = 0x0002
-	MOD_SHIFT   MOD = 0x0004
-	MOD_WIN     MOD = 0x0008
+	MOD_ALT      MOD = 0x0001
+	MOD_CONTROL  MOD = 0x0002
+	MOD_SHIFT    MOD = 0x0004
+	MOD_WIN      MOD = 0x0008
+	MOD_NOREPEAT MOD = 0x4000 // Used only in RegisterHotKey.
 )
 
 // [MonitorFromPoint], [MonitorFromRect] and [MonitorFromWindow] flags.
@@ -958,6 +1128,41 @@ const (
 	MONITOR_DEFAULTTONEAREST MONITOR = 0x0000_0002
 )
 
+// [RAWMOUSE] usFlags.
+//
+// [RAWMOUSE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawmouse
+type MOUSE uint16
+
+const (
+	MOUSE_MOVE_RELATIVE      MOUSE = 0x00
+	MOUSE_MOVE_ABSOLUTE      MOUSE = 0x01
+	MOUSE_VIRTUAL_DESKTOP    MOUSE = 0x02
+	MOUSE_ATTRIBUTES_CHANGED MOUSE = 0x04
+	MOUSE_MOVE_NOCOALESCE    MOUSE = 0x08
+)
+
+// [MOUSEINPUT] dwFlags.
+//
+// [MOUSEINPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mouseinput
+type MOUSEEVENTF uint32
+
+const (
+	MOUSEEVENTF_MOVE            MOUSEEVENTF = 0x0001
+	MOUSEEVENTF_LEFTDOWN        MOUSEEVENTF = 0x0002
+	MOUSEEVENTF_LEFTUP          MOUSEEVENTF = 0x0004
+	MOUSEEVENTF_RIGHTDOWN       MOUSEEVENTF = 0x0008
+	MOUSEEVENTF_RIGHTUP         MOUSEEVENTF = 0x0010
+	MOUSEEVENTF_MIDDLEDOWN      MOUSEEVENTF = 0x0020
+	MOUSEEVENTF_MIDDLEUP        MOUSEEVENTF = 0x0040
+	MOUSEEVENTF_XDOWN           MOUSEEVENTF = 0x0080
+	MOUSEEVENTF_XUP             MOUSEEVENTF = 0x0100
+	MOUSEEVENTF_WHEEL           MOUSEEVENTF = 0x0800
+	MOUSEEVENTF_HWHEEL          MOUSEEVENTF = 0x1000
+	MOUSEEVENTF_MOVE_NOCOALESCE MOUSEEVENTF = 0x2000
+	MOUSEEVENTF_VIRTUALDESK     MOUSEEVENTF = 0x4000
+	MOUSEEVENTF_ABSOLUTE        MOUSEEVENTF = 0x8000
+)
+
 // [WM_ENTERIDLE] displayed.
 //
 // [WM_ENTERIDLE]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/wm-enteridle
@@ -1087,6 +1292,99 @@ const (
 	QS_ALLINPUT          = QS_INPUT | QS_POSTMESSAGE | QS_TIMER | QS_PAINT | QS_HOTKEY | QS_SENDMESSAGE
 )
 
+// [RAWKEYBOARD] Flags.
+//
+// [RAWKEYBOARD]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawkeyboard
+type RI_KEY uint16
+
+const (
+	RI_KEY_MAKE  RI_KEY = 0
+	RI_KEY_BREAK RI_KEY = 1
+	RI_KEY_E0    RI_KEY = 2
+	RI_KEY_E1    RI_KEY = 4
+)
+
+// [RAWMOUSE] usButtonFlags.
+//
+// [RAWMOUSE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawmouse
+type RI_MOUSE uint16
+
+const (
+	RI_MOUSE_LEFT_BUTTON_DOWN   RI_MOUSE = 0x0001
+	RI_MOUSE_LEFT_BUTTON_UP     RI_MOUSE = 0x0002
+	RI_MOUSE_RIGHT_BUTTON_DOWN  RI_MOUSE = 0x0004
+	RI_MOUSE_RIGHT_BUTTON_UP    RI_MOUSE = 0x0008
+	RI_MOUSE_MIDDLE_BUTTON_DOWN RI_MOUSE = 0x0010
+	RI_MOUSE_MIDDLE_BUTTON_UP   RI_MOUSE = 0x0020
+	RI_MOUSE_BUTTON_4_DOWN      RI_MOUSE = 0x0040
+	RI_MOUSE_BUTTON_4_UP        RI_MOUSE = 0x0080
+	RI_MOUSE_BUTTON_5_DOWN      RI_MOUSE = 0x0100
+	RI_MOUSE_BUTTON_5_UP        RI_MOUSE = 0x0200
+	RI_MOUSE_WHEEL              RI_MOUSE = 0x0400
+	RI_MOUSE_HWHEEL             RI_MOUSE = 0x0800
+)
+
+// [GetRawInputData] uiCommand.
+//
+// [GetRawInputData]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdata
+type RID uint32
+
+const (
+	RID_INPUT  RID = 0x1000_0003
+	RID_HEADER RID = 0x1000_0005
+)
+
+// [RAWINPUTDEVICE] dwFlags.
+//
+// [RAWINPUTDEVICE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputdevice
+type RIDEV uint32
+
+const (
+	RIDEV_NONE         RIDEV = 0
+	RIDEV_REMOVE       RIDEV = 0x0000_0001
+	RIDEV_EXCLUDE      RIDEV = 0x0000_0010
+	RIDEV_PAGEONLY     RIDEV = 0x0000_0020
+	RIDEV_NOLEGACY     RIDEV = 0x0000_0030
+	RIDEV_INPUTSINK    RIDEV = 0x0000_0100
+	RIDEV_CAPTUREMOUSE RIDEV = 0x0000_0200
+	RIDEV_NOHOTKEYS    RIDEV = 0x0000_0200
+	RIDEV_APPKEYS      RIDEV = 0x0000_0400
+	RIDEV_EXINPUTSINK  RIDEV = 0x0000_1000
+	RIDEV_DEVNOTIFY    RIDEV = 0x0000_2000
+)
+
+// [GetRawInputDeviceInfo] uiCommand.
+//
+// [GetRawInputDeviceInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdeviceinfow
+type RIDI uint32
+
+const (
+	RIDI_PREPARSEDDATA RIDI = 0x2000_0005
+	RIDI_DEVICENAME    RIDI = 0x2000_0007
+	RIDI_DEVICEINFO    RIDI = 0x2000_000b
+)
+
+// [WM_INPUT] wParam.
+//
+// [WM_INPUT]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-input
+type RIM uint8
+
+const (
+	RIM_INPUT     RIM = 0
+	RIM_INPUTSINK RIM = 1
+)
+
+// [RAWINPUTHEADER] dwType.
+//
+// [RAWINPUTHEADER]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputheader
+type RIM_TYPE uint32
+
+const (
+	RIM_TYPEMOUSE    RIM_TYPE = 0
+	RIM_TYPEKEYBOARD RIM_TYPE = 1
+	RIM_TYPEHID      RIM_TYPE = 2
+)
+
 // Resource [types].
 //
 // [types]: https://learn.microsoft.com/en-us/windows/win32/menurc/resource-types
@@ -1144,6 +1442,37 @@ const (
 	SB_REQ_ENDSCROLL     SB_REQ = 8
 )
 
+// [GetScrollInfo] and [SetScrollInfo] nBar. Originally with SB prefix.
+//
+// [GetScrollInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getscrollinfo
+// [SetScrollInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setscrollinfo
+type SB_TYPE int32
+
+const (
+	SB_TYPE_HORZ SB_TYPE = 0 // Window horizontal scroll bar.
+	SB_TYPE_VERT SB_TYPE = 1 // Window vertical scroll bar.
+	SB_TYPE_CTL  SB_TYPE = 2 // Scroll bar control.
+	SB_TYPE_BOTH SB_TYPE = 3 // Both window scroll bars.
+)
+
+// Scroll bar control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/scroll-bar-control-styles
+type SBS WS
+
+const (
+	SBS_HORZ                    SBS = 0x0000
+	SBS_VERT                    SBS = 0x0001
+	SBS_TOPALIGN                SBS = 0x0002
+	SBS_LEFTALIGN               SBS = 0x0002
+	SBS_BOTTOMALIGN             SBS = 0x0004
+	SBS_RIGHTALIGN              SBS = 0x0004
+	SBS_SIZEBOXTOPLEFTALIGN     SBS = 0x0002
+	SBS_SIZEBOXBOTTOMRIGHTALIGN SBS = 0x0004
+	SBS_SIZEBOX                 SBS = 0x0008
+	SBS_SIZEGRIP                SBS = 0x0010
+)
+
 // [WM_SYSCOMMAND] type of requested command.
 //
 // [WM_SYSCOMMAND]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-syscommand
@@ -1172,6 +1501,18 @@ const (
 	SC_SEPARATOR    SC = 0xf00f
 )
 
+// [ScrollWindowEx] flags. Originally with SW prefix.
+//
+// [ScrollWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-scrollwindowex
+type SCROLLW uint32
+
+const (
+	SCROLLW_SCROLLCHILDREN SCROLLW = 0x0001
+	SCROLLW_INVALIDATE     SCROLLW = 0x0002
+	SCROLLW_ERASE          SCROLLW = 0x0004
+	SCROLLW_SMOOTHSCROLL   SCROLLW = 0x0010
+)
+
 // System shutdown reason [codes].
 //
 // [codes]: https://learn.microsoft.com/en-us/windows/win32/shutdown/system-shutdown-reason-codes
@@ -1240,6 +1581,20 @@ const (
 	SHTDN_UDIRTYUI = SHTDN_REASON_FLAG_DIRTY_UI
 )
 
+// [SCROLLINFO] fMask.
+//
+// [SCROLLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-scrollinfo
+type SIF uint32
+
+const (
+	SIF_RANGE           SIF = 0x0001
+	SIF_PAGE            SIF = 0x0002
+	SIF_POS             SIF = 0x0004
+	SIF_DISABLENOSCROLL SIF = 0x0008
+	SIF_TRACKPOS        SIF = 0x0010
+	SIF_ALL                 = SIF_RANGE | SIF_PAGE | SIF_POS | SIF_TRACKPOS
+)
+
 // [WM_SIZE] request.
 //
 // [WM_SIZE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-size
@@ -1904,6 +2259,29 @@ const (
 	WDA_EXCLUDEFROMCAPTURE WDA = 0x0000_0011
 )
 
+// [SetWindowsHookEx] idHook.
+//
+// [SetWindowsHookEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowshookexw
+type WH int32
+
+const (
+	WH_MSGFILTER       WH = -1
+	WH_JOURNALRECORD   WH = 0
+	WH_JOURNALPLAYBACK WH = 1
+	WH_KEYBOARD        WH = 2
+	WH_GETMESSAGE      WH = 3
+	WH_CALLWNDPROC     WH = 4
+	WH_CBT             WH = 5
+	WH_SYSMSGFILTER    WH = 6
+	WH_MOUSE           WH = 7
+	WH_DEBUG           WH = 9
+	WH_SHELL           WH = 10
+	WH_FOREGROUNDIDLE  WH = 11
+	WH_CALLWNDPROCRET  WH = 12
+	WH_KEYBOARD_LL     WH = 13
+	WH_MOUSE_LL        WH = 14
+)
+
 // [WM_PARENTNOTIFY] event.
 //
 // [WM_PARENTNOTIFY] https://learn.microsoft.com/en-us/windows/win32/inputmsg/wm-parentnotify
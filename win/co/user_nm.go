@@ -167,6 +167,18 @@ const (
 	IPN_FIELDCHANGED = _IPN_FIRST - 0
 )
 
+// ListBox control [notifications] (LBN).
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-box-control-reference-notifications
+const (
+	LBN_ERRSPACE  CMD = 0xfffe
+	LBN_SELCHANGE CMD = 1
+	LBN_DBLCLK    CMD = 2
+	LBN_SELCANCEL CMD = 3
+	LBN_SETFOCUS  CMD = 4
+	LBN_KILLFOCUS CMD = 5
+)
+
 // ListView control [notifications] (LVN).
 //
 // [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-view-control-reference-notifications
@@ -235,6 +247,33 @@ const (
 	RBN_AUTOBREAK     = _RBN_FIRST - 22
 )
 
+// RichEdit control [notifications] (EN).
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rich-edit-control-reference-notifications
+const (
+	EN_MSGFILTER         NM = 0x0700
+	EN_REQUESTRESIZE     NM = 0x0701
+	EN_SELCHANGE         NM = 0x0702
+	EN_DROPFILES         NM = 0x0703
+	EN_PROTECTED         NM = 0x0704
+	EN_CORRECTTEXT       NM = 0x0705
+	EN_STOPNOUNDO        NM = 0x0706
+	EN_IMECHANGE         NM = 0x0707
+	EN_SAVECLIPBOARD     NM = 0x0708
+	EN_OLEOPFAILED       NM = 0x0709
+	EN_OBJECTPOSITIONS   NM = 0x070a
+	EN_LINK              NM = 0x070b
+	EN_DRAGDROPDONE      NM = 0x070c
+	EN_PARAGRAPHEXPANDED NM = 0x070d
+	EN_PAGECHANGE        NM = 0x070e
+	EN_LOWFIRTF          NM = 0x070f
+	EN_ALIGNLTR          NM = 0x0710
+	EN_ALIGNRTL          NM = 0x0711
+	EN_CLIPFORMAT        NM = 0x0712
+	EN_STARTCOMPOSITION  NM = 0x0713
+	EN_ENDCOMPOSITION    NM = 0x0714
+)
+
 // StatusBar control [notifications] (SBN).
 //
 // [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-status-bars-reference-notifications
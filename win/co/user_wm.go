@@ -34,6 +34,7 @@ const (
 	WM_SYSCOLORCHANGE                 WM = 0x0015
 	WM_SHOWWINDOW                     WM = 0x0018
 	WM_WININICHANGE                   WM = 0x001a
+	WM_SETTINGCHANGE                  WM = 0x001a
 	WM_DEVMODECHANGE                  WM = 0x001b
 	WM_ACTIVATEAPP                    WM = 0x001c
 	WM_FONTCHANGE                     WM = 0x001d
@@ -157,6 +158,7 @@ const (
 	WM_MBUTTONDOWN                    WM = 0x0207
 	WM_MBUTTONUP                      WM = 0x0208
 	WM_MBUTTONDBLCLK                  WM = 0x0209
+	WM_MOUSEWHEEL                     WM = 0x020a
 	WM_MOUSEHWHEEL                    WM = 0x020e
 	WM_XBUTTONDOWN                    WM = 0x020b
 	WM_XBUTTONUP                      WM = 0x020c
@@ -491,6 +493,75 @@ const (
 	HDM_SETFOCUSEDITEM         = _HDM_FIRST + 28
 )
 
+// Hot key control [messages] (HKM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-hot-key-control-reference-messages
+const (
+	HKM_SETHOTKEY WM = WM_USER + 1
+	HKM_GETHOTKEY WM = WM_USER + 2
+	HKM_SETRULES  WM = WM_USER + 3
+)
+
+// IP address control [messages] (IPM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-ip-address-control-reference-messages
+const (
+	IPM_CLEARADDRESS WM = WM_USER + 100
+	IPM_SETADDRESS   WM = WM_USER + 101
+	IPM_GETADDRESS   WM = WM_USER + 102
+	IPM_SETRANGE     WM = WM_USER + 103
+	IPM_SETFOCUS     WM = WM_USER + 104
+	IPM_ISBLANK      WM = WM_USER + 105
+)
+
+// ListBox control [messages] (LB).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-box-control-reference-messages
+const (
+	LB_ADDSTRING           WM = 0x0180
+	LB_INSERTSTRING        WM = 0x0181
+	LB_DELETESTRING        WM = 0x0182
+	LB_SELITEMRANGEEX      WM = 0x0183
+	LB_RESETCONTENT        WM = 0x0184
+	LB_SETSEL              WM = 0x0185
+	LB_SETCURSEL           WM = 0x0186
+	LB_GETSEL              WM = 0x0187
+	LB_GETCURSEL           WM = 0x0188
+	LB_GETTEXT             WM = 0x0189
+	LB_GETTEXTLEN          WM = 0x018a
+	LB_GETCOUNT            WM = 0x018b
+	LB_SELECTSTRING        WM = 0x018c
+	LB_DIR                 WM = 0x018d
+	LB_GETTOPINDEX         WM = 0x018e
+	LB_FINDSTRING          WM = 0x018f
+	LB_GETSELCOUNT         WM = 0x0190
+	LB_GETSELITEMS         WM = 0x0191
+	LB_SETTABSTOPS         WM = 0x0192
+	LB_GETHORIZONTALEXTENT WM = 0x0193
+	LB_SETHORIZONTALEXTENT WM = 0x0194
+	LB_SETCOLUMNWIDTH      WM = 0x0195
+	LB_ADDFILE             WM = 0x0196
+	LB_SETTOPINDEX         WM = 0x0197
+	LB_GETITEMRECT         WM = 0x0198
+	LB_GETITEMDATA         WM = 0x0199
+	LB_SETITEMDATA         WM = 0x019a
+	LB_SELITEMRANGE        WM = 0x019b
+	LB_SETANCHORINDEX      WM = 0x019c
+	LB_GETANCHORINDEX      WM = 0x019d
+	LB_SETCARETINDEX       WM = 0x019e
+	LB_GETCARETINDEX       WM = 0x019f
+	LB_SETITEMHEIGHT       WM = 0x01a0
+	LB_GETITEMHEIGHT       WM = 0x01a1
+	LB_FINDSTRINGEXACT     WM = 0x01a2
+	LB_SETLOCALE           WM = 0x01a5
+	LB_GETLOCALE           WM = 0x01a6
+	LB_SETCOUNT            WM = 0x01a7
+	LB_INITSTORAGE         WM = 0x01a8
+	LB_ITEMFROMPOINT       WM = 0x01a9
+	LB_GETLISTBOXINFO      WM = 0x01b2
+	LB_MSGMAX              WM = 0x01b3
+)
+
 // ListView control [messages] (LVM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-view-control-reference-messages
@@ -685,6 +756,106 @@ const (
 	PBM_GETSTATE    = WM_USER + 17
 )
 
+// Rebar control [messages] (RB).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rebar-control-reference-messages
+const (
+	RB_DELETEBAND       = WM_USER + 2
+	RB_GETBARINFO       = WM_USER + 3
+	RB_SETBARINFO       = WM_USER + 4
+	RB_SETPARENT        = WM_USER + 7
+	RB_HITTEST          = WM_USER + 8
+	RB_GETRECT          = WM_USER + 9
+	RB_INSERTBAND       = WM_USER + 10
+	RB_SETBANDINFO      = WM_USER + 11
+	RB_GETBANDCOUNT     = WM_USER + 12
+	RB_GETROWCOUNT      = WM_USER + 13
+	RB_GETROWHEIGHT     = WM_USER + 14
+	RB_IDTOINDEX        = WM_USER + 16
+	RB_GETTOOLTIPS      = WM_USER + 17
+	RB_SETTOOLTIPS      = WM_USER + 18
+	RB_SETBKCOLOR       = WM_USER + 19
+	RB_GETBKCOLOR       = WM_USER + 20
+	RB_SETTEXTCOLOR     = WM_USER + 21
+	RB_GETTEXTCOLOR     = WM_USER + 22
+	RB_SIZETORECT       = WM_USER + 23
+	RB_BEGINDRAG        = WM_USER + 24
+	RB_ENDDRAG          = WM_USER + 25
+	RB_DRAGMOVE         = WM_USER + 26
+	RB_GETBARHEIGHT     = WM_USER + 27
+	RB_GETBANDINFO      = WM_USER + 28
+	RB_MINIMIZEBAND     = WM_USER + 30
+	RB_MAXIMIZEBAND     = WM_USER + 31
+	RB_GETBANDBORDERS   = WM_USER + 34
+	RB_SHOWBAND         = WM_USER + 35
+	RB_SETPALETTE       = WM_USER + 37
+	RB_GETPALETTE       = WM_USER + 38
+	RB_MOVEBAND         = WM_USER + 39
+	RB_GETBANDMARGINS   = WM_USER + 40
+	RB_SETEXTENDEDSTYLE = WM_USER + 41
+	RB_GETEXTENDEDSTYLE = WM_USER + 42
+	RB_PUSHCHEVRON      = WM_USER + 43
+	RB_SETBANDWIDTH     = WM_USER + 44
+	RB_SETWINDOWTHEME   = CCM_SETWINDOWTHEME
+)
+
+// RichEdit control [messages] (EM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rich-edit-control-reference-messages
+const (
+	EM_CANPASTE             = WM_USER + 50
+	EM_DISPLAYBAND          = WM_USER + 51
+	EM_EXGETSEL             = WM_USER + 52
+	EM_EXLIMITTEXT          = WM_USER + 53
+	EM_EXLINEFROMCHAR       = WM_USER + 54
+	EM_EXSETSEL             = WM_USER + 55
+	EM_FINDTEXT             = WM_USER + 56
+	EM_FORMATRANGE          = WM_USER + 57
+	EM_GETCHARFORMAT        = WM_USER + 58
+	EM_GETEVENTMASK         = WM_USER + 59
+	EM_GETOLEINTERFACE      = WM_USER + 60
+	EM_GETPARAFORMAT        = WM_USER + 61
+	EM_GETSELTEXT           = WM_USER + 62
+	EM_HIDESELECTION        = WM_USER + 63
+	EM_PASTESPECIAL         = WM_USER + 64
+	EM_REQUESTRESIZE        = WM_USER + 65
+	EM_SELECTIONTYPE        = WM_USER + 66
+	EM_SETBKGNDCOLOR        = WM_USER + 67
+	EM_SETCHARFORMAT        = WM_USER + 68
+	EM_SETEVENTMASK         = WM_USER + 69
+	EM_SETOLECALLBACK       = WM_USER + 70
+	EM_SETPARAFORMAT        = WM_USER + 71
+	EM_SETTARGETDEVICE      = WM_USER + 72
+	EM_STREAMIN             = WM_USER + 73
+	EM_STREAMOUT            = WM_USER + 74
+	EM_GETTEXTRANGE         = WM_USER + 75
+	EM_FINDWORDBREAK        = WM_USER + 76
+	EM_SETOPTIONS           = WM_USER + 77
+	EM_GETOPTIONS           = WM_USER + 78
+	EM_FINDTEXTEX           = WM_USER + 79
+	EM_SETUNDOLIMIT         = WM_USER + 82
+	EM_REDO                 = WM_USER + 84
+	EM_CANREDO              = WM_USER + 85
+	EM_GETUNDONAME          = WM_USER + 86
+	EM_GETREDONAME          = WM_USER + 87
+	EM_STOPGROUPTYPING      = WM_USER + 88
+	EM_SETTEXTMODE          = WM_USER + 89
+	EM_GETTEXTMODE          = WM_USER + 90
+	EM_AUTOURLDETECT        = WM_USER + 91
+	EM_GETAUTOURLDETECT     = WM_USER + 92
+	EM_GETTEXTEX            = WM_USER + 94
+	EM_GETTEXTLENGTHEX      = WM_USER + 95
+	EM_SHOWSCROLLBAR        = WM_USER + 96
+	EM_SETTEXTEX            = WM_USER + 97
+	EM_FINDTEXTW            = WM_USER + 123
+	EM_FINDTEXTEXW          = WM_USER + 124
+	EM_GETSCROLLPOS         = WM_USER + 221
+	EM_SETSCROLLPOS         = WM_USER + 222
+	EM_SETFONTSIZE          = WM_USER + 223
+	EM_SETTYPOGRAPHYOPTIONS = WM_USER + 202
+	EM_GETTYPOGRAPHYOPTIONS = WM_USER + 203
+)
+
 // Status bar control [messages] (SB).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-status-bars-reference-messages
@@ -884,6 +1055,46 @@ const (
 	TCM_GETUNICODEFORMAT = CCM_GETUNICODEFORMAT
 )
 
+// Tooltip control [messages] (TTM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tooltip-control-reference-messages
+const (
+	TTM_ACTIVATE        = WM_USER + 1
+	TTM_SETDELAYTIME    = WM_USER + 3
+	TTM_RELAYEVENT      = WM_USER + 7
+	TTM_GETTOOLCOUNT    = WM_USER + 13
+	TTM_WINDOWFROMPOINT = WM_USER + 16
+	TTM_TRACKACTIVATE   = WM_USER + 17
+	TTM_TRACKPOSITION   = WM_USER + 18
+	TTM_SETTIPBKCOLOR   = WM_USER + 19
+	TTM_SETTIPTEXTCOLOR = WM_USER + 20
+	TTM_GETDELAYTIME    = WM_USER + 21
+	TTM_GETTIPBKCOLOR   = WM_USER + 22
+	TTM_GETTIPTEXTCOLOR = WM_USER + 23
+	TTM_SETMAXTIPWIDTH  = WM_USER + 24
+	TTM_GETMAXTIPWIDTH  = WM_USER + 25
+	TTM_SETMARGIN       = WM_USER + 26
+	TTM_GETMARGIN       = WM_USER + 27
+	TTM_POP             = WM_USER + 28
+	TTM_UPDATE          = WM_USER + 29
+	TTM_GETBUBBLESIZE   = WM_USER + 30
+	TTM_ADJUSTRECT      = WM_USER + 31
+	TTM_SETTITLE        = WM_USER + 33
+	TTM_POPUP           = WM_USER + 34
+	TTM_GETTITLE        = WM_USER + 35
+	TTM_ADDTOOL         = WM_USER + 50
+	TTM_DELTOOL         = WM_USER + 51
+	TTM_NEWTOOLRECT     = WM_USER + 52
+	TTM_GETTOOLINFO     = WM_USER + 53
+	TTM_SETTOOLINFO     = WM_USER + 54
+	TTM_HITTEST         = WM_USER + 55
+	TTM_GETTEXT         = WM_USER + 56
+	TTM_UPDATETIPTEXT   = WM_USER + 57
+	TTM_ENUMTOOLS       = WM_USER + 58
+	TTM_GETCURRENTTOOL  = WM_USER + 59
+	TTM_SETWINDOWTHEME  = CCM_SETWINDOWTHEME
+)
+
 // TreeView control [messages] (TVM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tree-view-control-reference-messages
@@ -430,6 +430,15 @@ type NMITEMACTIVATE struct {
 	UKeyFlags co.LVKF
 }
 
+// [NMIPADDRESS] struct.
+//
+// [NMIPADDRESS]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmipaddress
+type NMIPADDRESS struct {
+	Hdr    NMHDR
+	IField int32
+	IValue int32
+}
+
 // [NMKEY] struct.
 //
 // [NMKEY]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmkey
@@ -666,6 +675,41 @@ type NMOBJECTNOTIFY struct {
 	DwFlags  uint32
 }
 
+// [NMREBAR] struct.
+//
+// [NMREBAR]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmrebar
+type NMREBAR struct {
+	Hdr    NMHDR
+	DwMask uint32 // RBNM_ID, RBNM_STYLE or RBNM_LPARAM
+	UBand  uint32
+	FStyle co.RBBS
+	WID    uint32
+	LParam LPARAM
+}
+
+// [NMREBARCHEVRON] struct.
+//
+// [NMREBARCHEVRON]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmrebarchevron
+type NMREBARCHEVRON struct {
+	Hdr      NMHDR
+	UBand    uint32
+	WID      uint32
+	LParam   LPARAM
+	Rc       RECT
+	LParamNM LPARAM
+}
+
+// [NMREBARCHILDSIZE] struct.
+//
+// [NMREBARCHILDSIZE]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmrebarchildsize
+type NMREBARCHILDSIZE struct {
+	Hdr     NMHDR
+	UBand   uint32
+	WID     uint32
+	RcChild RECT
+	RcBand  RECT
+}
+
 // [NMSELCHANGE] struct.
 //
 // [NMSELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmselchange
@@ -857,6 +901,25 @@ type NMTREEVIEW struct {
 	PtDrag  POINT
 }
 
+// [NMTTDISPINFO] struct.
+//
+// [NMTTDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmttdispinfow
+type NMTTDISPINFO struct {
+	Hdr      NMHDR
+	LpszText *uint16
+	szText   [80]uint16
+	Hinst    HINSTANCE
+	UFlags   co.TTF
+	LParam   LPARAM
+}
+
+func (di *NMTTDISPINFO) SzText() string {
+	return wstr.DecodeSlice(di.szText[:])
+}
+func (di *NMTTDISPINFO) SetSzText(val string) {
+	wstr.EncodeToBuf(val, di.szText[:])
+}
+
 // [NMTVASYNCDRAW] struct.
 //
 // [NMTVASYNCDRAW]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmtvasyncdraw
@@ -954,6 +1017,46 @@ type PBRANGE struct {
 	IHigh int32
 }
 
+// [REBARBANDINFO] struct.
+//
+// ⚠️ You must call [REBARBANDINFO.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var rbi win.REBARBANDINFO
+//	rbi.SetCbSize()
+//
+// [REBARBANDINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-rebarbandinfow
+type REBARBANDINFO struct {
+	cbSize            uint32
+	FMask             co.RBBIM
+	FStyle            co.RBBS
+	ClrFore           COLORREF
+	ClrBack           COLORREF
+	LpText            *uint16
+	Cch               uint32
+	IImage            int32
+	HwndChild         HWND
+	CxMinChild        uint32
+	CyMinChild        uint32
+	Cx                uint32
+	HbmBack           HBITMAP
+	WID               uint32
+	CyChild           uint32
+	CyMaxChild        uint32
+	CyIntegral        uint32
+	CxIdeal           uint32
+	LParam            LPARAM
+	CxHeader          uint32
+	RcChevronLocation RECT
+	UChevronState     uint32
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (rbi *REBARBANDINFO) SetCbSize() {
+	rbi.cbSize = uint32(unsafe.Sizeof(*rbi))
+}
+
 // [TASKDIALOG_BUTTON] struct syntactic sugar.
 //
 // This struct originally has a packed alignment, so we serialized it before the
@@ -1107,6 +1210,59 @@ type TBBUTTON struct {
 	IString   *uint16 // Can also be the index in the string list.
 }
 
+// [TTTOOLINFO] struct.
+//
+// ⚠️ You must call [TTTOOLINFO.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var ti win.TTTOOLINFO
+//	ti.SetCbSize()
+//
+// [TTTOOLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tttoolinfow
+type TTTOOLINFO struct {
+	cbSize     uint32
+	UFlags     co.TTF
+	Hwnd       HWND
+	UId        uintptr
+	Rect       RECT
+	Hinst      HINSTANCE
+	lpszText   uintptr // *uint16 or LPSTR_TEXTCALLBACK.
+	LParam     LPARAM
+	lpReserved uintptr
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (ti *TTTOOLINFO) SetCbSize() {
+	ti.cbSize = uint32(unsafe.Sizeof(*ti))
+}
+
+// Returns true if the lpszText field is LPSTR_TEXTCALLBACK.
+func (ti *TTTOOLINFO) IsLpszTextCallback() bool {
+	return ti.lpszText == utl.LPSTR_TEXTCALLBACK
+}
+
+// Sets the lpszText field to the given null-terminated string. The string
+// buffer must be kept alive while the struct is in use.
+func (ti *TTTOOLINFO) SetLpszText(val *uint16) {
+	ti.lpszText = uintptr(unsafe.Pointer(val))
+}
+
+// Sets the lpszText field to LPSTR_TEXTCALLBACK, so the text will be requested
+// with TTN_GETDISPINFO.
+func (ti *TTTOOLINFO) SetLpszTextCallback() {
+	ti.lpszText = utl.LPSTR_TEXTCALLBACK
+}
+
+// [TVHITTESTINFO] struct.
+//
+// [TVHITTESTINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tvhittestinfo
+type TVHITTESTINFO struct {
+	Pt    POINT // Coordinates relative to tree view.
+	Flags co.TVHT
+	HItem HTREEITEM // Zero if no item.
+}
+
 // [TVINSERTSTRUCT] struct.
 //
 // [TVINSERTSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tvinsertstructw
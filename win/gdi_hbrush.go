@@ -49,6 +49,23 @@ func CreatePatternBrush(hbm HBITMAP) (HBRUSH, error) {
 
 var _CreatePatternBrush *syscall.Proc
 
+// [CreateSolidBrush] function.
+//
+// ⚠️ You must defer [HBRUSH.DeleteObject].
+//
+// [CreateSolidBrush]: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/nf-wingdi-createsolidbrush
+func CreateSolidBrush(color COLORREF) (HBRUSH, error) {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.GDI32, &_CreateSolidBrush, "CreateSolidBrush"),
+		uintptr(color))
+	if ret == 0 {
+		return HBRUSH(0), co.ERROR_INVALID_PARAMETER
+	}
+	return HBRUSH(ret), nil
+}
+
+var _CreateSolidBrush *syscall.Proc
+
 // [DeleteObject] function.
 //
 // [DeleteObject]: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/nf-wingdi-deleteobject
@@ -0,0 +1,116 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Handle to a [waitable timer].
+//
+// [waitable timer]: https://learn.microsoft.com/en-us/windows/win32/sync/waitable-timer-objects
+type HWAITABLETIMER HANDLE
+
+// [CreateWaitableTimerEx] function.
+//
+// ⚠️ You must defer [HWAITABLETIMER.CloseHandle].
+//
+// # Example
+//
+//	hTimer, _ := win.CreateWaitableTimerEx(nil, "",
+//		co.CREATE_WAITABLE_TIMER_HIGH_RESOLUTION, co.TIMER_ALL_ACCESS)
+//	defer hTimer.CloseHandle()
+//
+//	hTimer.SetWaitableTimer(-10_000, 0) // 1 millisecond from now
+//	hTimer.WaitForSingleObjectInfinite()
+//
+// [CreateWaitableTimerEx]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createwaitabletimerexw
+func CreateWaitableTimerEx(
+	securityAttributes *SECURITY_ATTRIBUTES,
+	timerName string,
+	flags co.CREATE_WAITABLE_TIMER,
+	desiredAccess co.TIMER,
+) (HWAITABLETIMER, error) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+	pTimerName := wbuf.PtrEmptyIsNil(timerName)
+
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_CreateWaitableTimerExW, "CreateWaitableTimerExW"),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		uintptr(pTimerName),
+		uintptr(flags),
+		uintptr(desiredAccess))
+	if ret == 0 {
+		return HWAITABLETIMER(0), co.ERROR(err)
+	}
+	return HWAITABLETIMER(ret), nil
+}
+
+var _CreateWaitableTimerExW *syscall.Proc
+
+// [CancelWaitableTimer] function.
+//
+// [CancelWaitableTimer]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-cancelwaitabletimer
+func (hTimer HWAITABLETIMER) CancelWaitableTimer() error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_CancelWaitableTimer, "CancelWaitableTimer"),
+		uintptr(hTimer))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _CancelWaitableTimer *syscall.Proc
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hTimer HWAITABLETIMER) CloseHandle() error {
+	return HANDLE(hTimer).CloseHandle()
+}
+
+// [SetWaitableTimer] function.
+//
+// The dueTime is given in 100-nanosecond intervals: a positive value is an
+// absolute time in the FILETIME format, and a negative value is relative to
+// now. The period is given in milliseconds; if zero, the timer is signaled
+// once.
+//
+// [SetWaitableTimer]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-setwaitabletimer
+func (hTimer HWAITABLETIMER) SetWaitableTimer(dueTime int64, period int32) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_SetWaitableTimer, "SetWaitableTimer"),
+		uintptr(hTimer),
+		uintptr(unsafe.Pointer(&dueTime)),
+		uintptr(period),
+		0, 0, 0)
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _SetWaitableTimer *syscall.Proc
+
+// [WaitForSingleObject] function.
+//
+// For INFINITE, use [HWAITABLETIMER.WaitForSingleObjectInfinite].
+//
+// [WaitForSingleObject]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
+func (hTimer HWAITABLETIMER) WaitForSingleObject(milliseconds uint) (co.WAIT, error) {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_WaitForSingleObject, "WaitForSingleObject"),
+		uintptr(hTimer),
+		uintptr(milliseconds))
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, co.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
+
+// [HWAITABLETIMER.WaitForSingleObject] function with INFINITE value.
+func (hTimer HWAITABLETIMER) WaitForSingleObjectInfinite() (co.WAIT, error) {
+	return hTimer.WaitForSingleObject(utl.INFINITE)
+}
@@ -0,0 +1,206 @@
+//go:build windows
+
+package win
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// [CHARFORMAT2] struct.
+//
+// ⚠️ You must call [CHARFORMAT2.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var cf win.CHARFORMAT2
+//	cf.SetCbSize()
+//
+// [CHARFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charformat2w
+type CHARFORMAT2 struct {
+	cbSize          uint32
+	DwMask          co.CFM
+	DwEffects       co.CFE
+	YHeight         int32 // In twips.
+	YOffset         int32
+	CrTextColor     COLORREF
+	BCharSet        co.CHARSET
+	bPitchAndFamily uint8 // combination of co.PITCH and co.FF
+	szFaceName      [utl.LF_FACESIZE]uint16
+	WWeight         uint16
+	SSpacing        int16
+	CrBackColor     COLORREF
+	Lcid            LCID
+	DwCookie        uint32
+	SStyle          int16
+	WKerning        uint16
+	BUnderlineType  uint8
+	BAnimation      uint8
+	BRevAuthor      uint8
+	BUnderlineColor uint8
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (cf *CHARFORMAT2) SetCbSize() {
+	cf.cbSize = uint32(unsafe.Sizeof(*cf))
+}
+
+func (cf *CHARFORMAT2) SzFaceName() string {
+	return wstr.DecodeSlice(cf.szFaceName[:])
+}
+func (cf *CHARFORMAT2) SetSzFaceName(val string) {
+	wstr.EncodeToBuf(val, cf.szFaceName[:])
+}
+
+func (cf *CHARFORMAT2) Pitch() co.PITCH {
+	return co.PITCH(cf.bPitchAndFamily & 0b1111)
+}
+func (cf *CHARFORMAT2) SetPitch(val co.PITCH) {
+	cf.bPitchAndFamily &^= 0b1111 // clear bits
+	cf.bPitchAndFamily |= uint8(val & 0b1111)
+}
+
+func (cf *CHARFORMAT2) Family() co.FF {
+	return co.FF(cf.bPitchAndFamily & 0b1111_0000)
+}
+func (cf *CHARFORMAT2) SetFamily(val co.FF) {
+	cf.bPitchAndFamily &^= 0b1111_0000 // clear bits
+	cf.bPitchAndFamily |= uint8(val & 0b1111_0000)
+}
+
+// [CHARRANGE] struct.
+//
+// [CHARRANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charrange
+type CHARRANGE struct {
+	CpMin int32
+	CpMax int32
+}
+
+// Number of uint32 words in an uintptr. Rich edit structs are packed to 4
+// bytes, so their pointer-sized fields are stored as arrays of these words.
+const _PACK4_PTR = unsafe.Sizeof(uintptr(0)) / 4
+
+// [EDITSTREAM] struct.
+//
+// This struct is packed to 4 bytes, so the pointer-sized fields are accessed
+// through methods.
+//
+// [EDITSTREAM]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-editstream
+type EDITSTREAM struct {
+	dwCookie    [_PACK4_PTR]uint32
+	DwError     uint32
+	pfnCallback [_PACK4_PTR]uint32
+}
+
+func (es *EDITSTREAM) DwCookie() uintptr {
+	return *(*uintptr)(unsafe.Pointer(&es.dwCookie[0]))
+}
+func (es *EDITSTREAM) SetDwCookie(val uintptr) {
+	*(*uintptr)(unsafe.Pointer(&es.dwCookie[0])) = val
+}
+
+func (es *EDITSTREAM) PfnCallback() uintptr {
+	return *(*uintptr)(unsafe.Pointer(&es.pfnCallback[0]))
+}
+func (es *EDITSTREAM) SetPfnCallback(val uintptr) {
+	*(*uintptr)(unsafe.Pointer(&es.pfnCallback[0])) = val
+}
+
+// [ENLINK] struct.
+//
+// This struct is packed to 4 bytes, so the wParam and lParam fields are
+// accessed through methods.
+//
+// [ENLINK]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-enlink
+type ENLINK struct {
+	Nmhdr  NMHDR
+	Msg    co.WM
+	wParam [_PACK4_PTR]uint32
+	lParam [_PACK4_PTR]uint32
+	Chrg   CHARRANGE
+}
+
+func (el *ENLINK) WParam() WPARAM {
+	return *(*WPARAM)(unsafe.Pointer(&el.wParam[0]))
+}
+func (el *ENLINK) SetWParam(val WPARAM) {
+	*(*WPARAM)(unsafe.Pointer(&el.wParam[0])) = val
+}
+
+func (el *ENLINK) LParam() LPARAM {
+	return *(*LPARAM)(unsafe.Pointer(&el.lParam[0]))
+}
+func (el *ENLINK) SetLParam(val LPARAM) {
+	*(*LPARAM)(unsafe.Pointer(&el.lParam[0])) = val
+}
+
+// [FINDTEXTEX] struct.
+//
+// [FINDTEXTEX]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-findtextexw
+type FINDTEXTEX struct {
+	Chrg      CHARRANGE
+	LpstrText *uint16
+	ChrgText  CHARRANGE
+}
+
+// [PARAFORMAT2] struct.
+//
+// ⚠️ You must call [PARAFORMAT2.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var pf win.PARAFORMAT2
+//	pf.SetCbSize()
+//
+// [PARAFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-paraformat2
+type PARAFORMAT2 struct {
+	cbSize           uint32
+	DwMask           co.PFM
+	WNumbering       co.PFN
+	WEffects         uint16
+	DxStartIndent    int32 // In twips.
+	DxRightIndent    int32 // In twips.
+	DxOffset         int32 // In twips.
+	WAlignment       co.PFA
+	CTabCount        int16
+	RgxTabs          [32]int32
+	DySpaceBefore    int32
+	DySpaceAfter     int32
+	DyLineSpacing    int32
+	SStyle           int16
+	BLineSpacingRule uint8
+	BOutlineLevel    uint8
+	WShadingWeight   uint16
+	WShadingStyle    uint16
+	WNumberingStart  uint16
+	WNumberingStyle  uint16
+	WNumberingTab    uint16
+	WBorderSpace     uint16
+	WBorderWidth     uint16
+	WBorders         uint16
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (pf *PARAFORMAT2) SetCbSize() {
+	pf.cbSize = uint32(unsafe.Sizeof(*pf))
+}
+
+// [SELCHANGE] struct.
+//
+// [SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-selchange
+type SELCHANGE struct {
+	Nmhdr  NMHDR
+	Chrg   CHARRANGE
+	Seltyp co.SEL
+}
+
+// [TEXTRANGE] struct.
+//
+// [TEXTRANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-textrangew
+type TEXTRANGE struct {
+	Chrg      CHARRANGE
+	LpstrText *uint16
+}
@@ -97,6 +97,22 @@ func (nid *NOTIFYICONDATA) SetSzInfo(val string) {
 	wstr.EncodeToBuf(val, nid.szInfo[:])
 }
 
+// Returns the uTimeout field, which shares memory with uVersion.
+func (nid *NOTIFYICONDATA) UTimeout() uint32 {
+	return nid.uVersion
+}
+func (nid *NOTIFYICONDATA) SetUTimeout(val uint32) {
+	nid.uVersion = val
+}
+
+// Returns the uVersion field, which shares memory with uTimeout.
+func (nid *NOTIFYICONDATA) UVersion() co.NOTIFYICON_VERSION {
+	return co.NOTIFYICON_VERSION(nid.uVersion)
+}
+func (nid *NOTIFYICONDATA) SetUVersion(val co.NOTIFYICON_VERSION) {
+	nid.uVersion = uint32(val)
+}
+
 func (nid *NOTIFYICONDATA) SzInfoTitle() string {
 	return wstr.DecodeSlice(nid.szInfoTitle[:])
 }
@@ -362,6 +362,19 @@ func GetDialogBaseUnits() (horz, vert uint16) {
 
 var _GetDialogBaseUnits *syscall.Proc
 
+// [GetDpiForSystem] function.
+//
+// Available on Windows 10 version 1607 and later.
+//
+// [GetDpiForSystem]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdpiforsystem
+func GetDpiForSystem() uint32 {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetDpiForSystem, "GetDpiForSystem"))
+	return uint32(ret)
+}
+
+var _GetDpiForSystem *syscall.Proc
+
 // [GetGUIThreadInfo] function.
 //
 // [GetGUIThreadInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getguithreadinfo
@@ -392,6 +405,26 @@ func GetInputState() bool {
 
 var _GetInputState *syscall.Proc
 
+// [GetKeyNameText] function.
+//
+// [GetKeyNameText]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getkeynametextw
+func GetKeyNameText(lParam int32) (string, error) {
+	recvBuf := wstr.NewBufDecoder(64)
+	defer recvBuf.Free()
+
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetKeyNameTextW, "GetKeyNameTextW"),
+		uintptr(lParam),
+		uintptr(recvBuf.UnsafePtr()),
+		uintptr(int32(recvBuf.Len())))
+	if ret == 0 {
+		return "", co.ERROR(err)
+	}
+	return recvBuf.String(), nil
+}
+
+var _GetKeyNameTextW *syscall.Proc
+
 // [GetMessage] function.
 //
 // [GetMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getmessagew
@@ -492,6 +525,107 @@ func GetQueueStatus(flags co.QS) (currentlyInQueue, addedToQueue co.QS) {
 
 var _GetQueueStatus *syscall.Proc
 
+// [GetRawInputDeviceInfo] function, with co.RIDI_DEVICEINFO.
+//
+// [GetRawInputDeviceInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdeviceinfow
+func GetRawInputDeviceInfo(hDevice HANDLE) (RID_DEVICE_INFO, error) {
+	var info RID_DEVICE_INFO
+	info.SetCbSize()
+	szInfo := uint32(unsafe.Sizeof(info))
+
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetRawInputDeviceInfoW, "GetRawInputDeviceInfoW"),
+		uintptr(hDevice),
+		uintptr(co.RIDI_DEVICEINFO),
+		uintptr(unsafe.Pointer(&info)),
+		uintptr(unsafe.Pointer(&szInfo)))
+	if int32(ret) == -1 {
+		return RID_DEVICE_INFO{}, co.ERROR(err)
+	}
+	return info, nil
+}
+
+// [GetRawInputDeviceInfo] function, with co.RIDI_DEVICENAME.
+//
+// The returned name can be passed to [CreateFile] to open the device.
+//
+// [GetRawInputDeviceInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdeviceinfow
+func GetRawInputDeviceName(hDevice HANDLE) (string, error) {
+	var numChars uint32
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetRawInputDeviceInfoW, "GetRawInputDeviceInfoW"),
+		uintptr(hDevice),
+		uintptr(co.RIDI_DEVICENAME),
+		0,
+		uintptr(unsafe.Pointer(&numChars)))
+	if int32(ret) == -1 {
+		return "", co.ERROR(err)
+	}
+
+	recvBuf := wstr.NewBufDecoder(uint(numChars) + 1) // room for terminating null
+	defer recvBuf.Free()
+	numChars = uint32(recvBuf.Len())
+
+	ret, _, err = syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetRawInputDeviceInfoW, "GetRawInputDeviceInfoW"),
+		uintptr(hDevice),
+		uintptr(co.RIDI_DEVICENAME),
+		uintptr(recvBuf.UnsafePtr()),
+		uintptr(unsafe.Pointer(&numChars)))
+	if int32(ret) == -1 {
+		return "", co.ERROR(err)
+	}
+	return recvBuf.String(), nil
+}
+
+var _GetRawInputDeviceInfoW *syscall.Proc
+
+// [GetRawInputDeviceList] function.
+//
+// # Example
+//
+//	devices, _ := win.GetRawInputDeviceList()
+//	for _, dev := range devices {
+//		name, _ := win.GetRawInputDeviceName(dev.HDevice)
+//		println(dev.DwType, name)
+//	}
+//
+// [GetRawInputDeviceList]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdevicelist
+func GetRawInputDeviceList() ([]RAWINPUTDEVICELIST, error) {
+	szItem := unsafe.Sizeof(RAWINPUTDEVICELIST{})
+
+	for {
+		var numDevices uint32
+		ret, _, err := syscall.SyscallN(
+			dll.Load(dll.USER32, &_GetRawInputDeviceList, "GetRawInputDeviceList"),
+			0,
+			uintptr(unsafe.Pointer(&numDevices)),
+			szItem)
+		if int32(ret) == -1 {
+			return nil, co.ERROR(err)
+		} else if numDevices == 0 {
+			return []RAWINPUTDEVICELIST{}, nil
+		}
+
+		devices := make([]RAWINPUTDEVICELIST, numDevices)
+		ret, _, err = syscall.SyscallN(
+			dll.Load(dll.USER32, &_GetRawInputDeviceList, "GetRawInputDeviceList"),
+			uintptr(unsafe.Pointer(&devices[0])),
+			uintptr(unsafe.Pointer(&numDevices)),
+			szItem)
+		if int32(ret) == -1 {
+			if wErr := co.ERROR(err); wErr == co.ERROR_INSUFFICIENT_BUFFER {
+				continue // a device was plugged in meanwhile, try again
+			} else {
+				return nil, wErr
+			}
+		}
+		return devices[:ret], nil
+	}
+}
+
+var _GetRawInputDeviceList *syscall.Proc
+
 // [GetSysColor] function.
 //
 // [GetSysColor]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getsyscolor
@@ -601,6 +735,19 @@ func LockWorkStation() error {
 
 var _LockWorkStation *syscall.Proc
 
+// [MapVirtualKey] function.
+//
+// [MapVirtualKey]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-mapvirtualkeyw
+func MapVirtualKey(code uint32, mapType co.MAPVK) uint32 {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_MapVirtualKeyW, "MapVirtualKeyW"),
+		uintptr(code),
+		uintptr(mapType))
+	return uint32(ret)
+}
+
+var _MapVirtualKeyW *syscall.Proc
+
 // [OffsetRect] function.
 //
 // [OffsetRect]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-offsetrect
@@ -694,6 +841,35 @@ func RegisterClipboardFormat(name string) (co.CF, error) {
 
 var _RegisterClipboardFormatW *syscall.Proc
 
+// [RegisterRawInputDevices] function.
+//
+// If devices is empty, does nothing.
+//
+// # Example
+//
+//	var hWnd win.HWND // initialized somewhere
+//
+//	win.RegisterRawInputDevices([]win.RAWINPUTDEVICE{
+//		{UsUsagePage: 0x01, UsUsage: 0x02, HwndTarget: hWnd}, // mouse
+//		{UsUsagePage: 0x01, UsUsage: 0x06, HwndTarget: hWnd}, // keyboard
+//	})
+//
+// [RegisterRawInputDevices]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerrawinputdevices
+func RegisterRawInputDevices(devices []RAWINPUTDEVICE) error {
+	if len(devices) == 0 {
+		return nil
+	}
+
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_RegisterRawInputDevices, "RegisterRawInputDevices"),
+		uintptr(unsafe.Pointer(&devices[0])),
+		uintptr(len(devices)),
+		unsafe.Sizeof(RAWINPUTDEVICE{}))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _RegisterRawInputDevices *syscall.Proc
+
 // [RegisterWindowMessage] function.
 //
 // [RegisterWindowMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerwindowmessagew
@@ -715,6 +891,17 @@ func RegisterWindowMessage(message string) (co.WM, error) {
 
 var _RegisterWindowMessageW *syscall.Proc
 
+// [ReleaseCapture] function.
+//
+// [ReleaseCapture]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-releasecapture
+func ReleaseCapture() error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_ReleaseCapture, "ReleaseCapture"))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _ReleaseCapture *syscall.Proc
+
 // [ReplyMessage] function.
 //
 // [ReplyMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-replymessage
@@ -727,6 +914,42 @@ func ReplyMessage(result uintptr) bool {
 
 var _ReplyMessage *syscall.Proc
 
+// [SendInput] function.
+//
+// Returns the number of events actually inserted, which can be fewer than
+// given if the input is blocked by another thread or by [UIPI]. If inputs is
+// empty, does nothing.
+//
+// # Example
+//
+//	inputs := make([]win.INPUT, 2)
+//	for i := range inputs {
+//		inputs[i].Type = co.INPUT_KEYBOARD
+//		inputs[i].Keyboard().WVk = co.VK_RETURN
+//	}
+//	inputs[1].Keyboard().DwFlags = co.KEYEVENTF_KEYUP
+//	win.SendInput(inputs)
+//
+// [SendInput]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendinput
+// [UIPI]: https://learn.microsoft.com/en-us/windows/win32/winauto/uiauto-securityoverview
+func SendInput(inputs []INPUT) (uint, error) {
+	if len(inputs) == 0 {
+		return 0, nil
+	}
+
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SendInput, "SendInput"),
+		uintptr(len(inputs)),
+		uintptr(unsafe.Pointer(&inputs[0])),
+		unsafe.Sizeof(INPUT{}))
+	if ret == 0 {
+		return 0, co.ERROR(err)
+	}
+	return uint(ret), nil
+}
+
+var _SendInput *syscall.Proc
+
 // [SetCaretPos] function.
 //
 // [SetCaretPos]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setcaretpos
@@ -788,6 +1011,20 @@ func SetProcessDPIAware() error {
 
 var _SetProcessDPIAware *syscall.Proc
 
+// [SetProcessDpiAwarenessContext] function.
+//
+// Available on Windows 10 version 1703 and later.
+//
+// [SetProcessDpiAwarenessContext]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setprocessdpiawarenesscontext
+func SetProcessDpiAwarenessContext(value co.DPI_AWARENESS_CONTEXT) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SetProcessDpiAwarenessContext, "SetProcessDpiAwarenessContext"),
+		uintptr(value))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _SetProcessDpiAwarenessContext *syscall.Proc
+
 // [ShowCursor] function.
 //
 // [ShowCursor]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-showcursor
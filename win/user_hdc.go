@@ -12,6 +12,19 @@ import (
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
+// [DrawFocusRect] function.
+//
+// [DrawFocusRect]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-drawfocusrect
+func (hdc HDC) DrawFocusRect(rc *RECT) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_DrawFocusRect, "DrawFocusRect"),
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(rc)))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _DrawFocusRect *syscall.Proc
+
 // [DrawIcon] function.
 //
 // [DrawIcon]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-drawicon
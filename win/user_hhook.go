@@ -0,0 +1,171 @@
+//go:build windows
+
+package win
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Handle to a [hook].
+//
+// [hook]: https://learn.microsoft.com/en-us/windows/win32/winprog/windows-data-types#hhook
+type HHOOK HANDLE
+
+// [SetWindowsHookEx] function.
+//
+// The callback receives the hook code, and the meaning of wParam and lParam
+// depends on the hook type. Unless the message is to be blocked, the callback
+// must return the result of [HHOOK.CallNextHookEx].
+//
+// The hook procedure runs in the thread which installed the hook, which must
+// run a message loop. Since a Go program cannot be injected into other
+// processes, global hooks are limited to co.WH_KEYBOARD_LL and co.WH_MOUSE_LL;
+// other hook types must target a thread of the current process.
+//
+// ⚠️ You must defer [HHOOK.UnhookWindowsHookEx].
+//
+// # Example
+//
+//	hHook, _ := win.SetWindowsHookEx(co.WH_CBT,
+//		func(code int32, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+//			if co.HCBT(code) == co.HCBT_ACTIVATE {
+//				println("Activated", win.HWND(wParam))
+//			}
+//			return win.HHOOK(0).CallNextHookEx(code, wParam, lParam)
+//		},
+//		win.HINSTANCE(0), win.GetCurrentThreadId())
+//	defer hHook.UnhookWindowsHookEx()
+//
+// [SetWindowsHookEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowshookexw
+func SetWindowsHookEx(
+	idHook co.WH,
+	callback func(code int32, wParam WPARAM, lParam LPARAM) uintptr,
+	hMod HINSTANCE,
+	threadId uint32,
+) (HHOOK, error) {
+	slot, pProc := _hookSlots.alloc(callback)
+
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SetWindowsHookExW, "SetWindowsHookExW"),
+		uintptr(idHook),
+		pProc,
+		uintptr(hMod),
+		uintptr(threadId))
+	if ret == 0 {
+		_hookSlots.free(slot)
+		return HHOOK(0), co.ERROR(err)
+	}
+
+	hHook := HHOOK(ret)
+	_hookSlots.bind(slot, hHook)
+	return hHook, nil
+}
+
+var _SetWindowsHookExW *syscall.Proc
+
+// [CallNextHookEx] function.
+//
+// The handle itself is ignored by the system, so it can be zero.
+//
+// [CallNextHookEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-callnexthookex
+func (hHook HHOOK) CallNextHookEx(code int32, wParam WPARAM, lParam LPARAM) uintptr {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_CallNextHookEx, "CallNextHookEx"),
+		uintptr(hHook),
+		uintptr(code),
+		uintptr(wParam),
+		uintptr(lParam))
+	return ret
+}
+
+var _CallNextHookEx *syscall.Proc
+
+// [UnhookWindowsHookEx] function.
+//
+// [UnhookWindowsHookEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-unhookwindowshookex
+func (hHook HHOOK) UnhookWindowsHookEx() error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_UnhookWindowsHookEx, "UnhookWindowsHookEx"),
+		uintptr(hHook))
+	if wErr := utl.ZeroAsGetLastError(ret, err); wErr != nil {
+		return wErr
+	}
+	_hookSlots.freeHook(hHook)
+	return nil
+}
+
+var _UnhookWindowsHookEx *syscall.Proc
+
+// Hook procedures don't receive any user data, so each installed hook uses its
+// own syscall callback. Since syscall callbacks are never released, they are
+// kept in slots, which are reused after the hooks are removed.
+type _HookSlots struct {
+	mutex     sync.Mutex
+	callbacks []uintptr
+	funcs     []func(code int32, wParam WPARAM, lParam LPARAM) uintptr
+	hooks     []HHOOK
+}
+
+var _hookSlots _HookSlots
+
+// Stores the function in a free slot, creating a new one if needed. Returns
+// the slot index and its syscall callback.
+func (me *_HookSlots) alloc(
+	fun func(code int32, wParam WPARAM, lParam LPARAM) uintptr,
+) (int, uintptr) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	for i := range me.funcs {
+		if me.funcs[i] == nil {
+			me.funcs[i] = fun
+			return i, me.callbacks[i]
+		}
+	}
+
+	slot := len(me.funcs)
+	me.funcs = append(me.funcs, fun)
+	me.hooks = append(me.hooks, HHOOK(0))
+	me.callbacks = append(me.callbacks, syscall.NewCallback(
+		func(code int32, wParam WPARAM, lParam LPARAM) uintptr {
+			me.mutex.Lock()
+			fun := me.funcs[slot]
+			me.mutex.Unlock()
+			if fun == nil { // hook being removed
+				return HHOOK(0).CallNextHookEx(code, wParam, lParam)
+			}
+			return fun(code, wParam, lParam)
+		},
+	))
+	return slot, me.callbacks[slot]
+}
+
+func (me *_HookSlots) bind(slot int, hHook HHOOK) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.hooks[slot] = hHook
+}
+
+func (me *_HookSlots) free(slot int) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.funcs[slot] = nil
+	me.hooks[slot] = HHOOK(0)
+}
+
+func (me *_HookSlots) freeHook(hHook HHOOK) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	for i := range me.hooks {
+		if me.hooks[i] == hHook {
+			me.funcs[i] = nil
+			me.hooks[i] = HHOOK(0)
+			break
+		}
+	}
+}
@@ -11,6 +11,32 @@ import (
 	"github.com/rodrigocfd/windigo/win/wstr"
 )
 
+// [CreateDialogIndirectParam] function.
+//
+// The template can be built with [DLGTEMPLATEEX.Serialize].
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (hInst HINSTANCE) CreateDialogIndirectParam(
+	template *DLGTEMPLATE,
+	hwndParent HWND,
+	dialogFunc uintptr,
+	dwInitParam LPARAM,
+) (HWND, error) {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_CreateDialogIndirectParamW, "CreateDialogIndirectParamW"),
+		uintptr(hInst),
+		uintptr(unsafe.Pointer(template)),
+		uintptr(hwndParent),
+		dialogFunc,
+		uintptr(dwInitParam))
+	if ret == 0 {
+		return HWND(0), co.ERROR(err)
+	}
+	return HWND(ret), nil
+}
+
+var _CreateDialogIndirectParamW *syscall.Proc
+
 // [CreateDialogParam] function.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
@@ -41,6 +67,8 @@ var _CreateDialogParamW *syscall.Proc
 
 // [DialogBoxIndirectParam] function.
 //
+// The template can be built with [DLGTEMPLATEEX.Serialize].
+//
 // [DialogBoxIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-dialogboxindirectparamw
 func (hInst HINSTANCE) DialogBoxIndirectParam(
 	template *DLGTEMPLATE,
@@ -0,0 +1,67 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Handle to the [raw input] of a WM_INPUT message.
+//
+// [raw input]: https://learn.microsoft.com/en-us/windows/win32/inputdev/raw-input
+type HRAWINPUT HANDLE
+
+// [GetRawInputData] function, with co.RID_INPUT.
+//
+// The returned struct is allocated with the exact size of the data, so the
+// reports of [RAWINPUT.Hid] can be safely read.
+//
+// # Example
+//
+//	var hRaw win.HRAWINPUT // initialized somewhere
+//
+//	ri, _ := hRaw.GetRawInputData()
+//	if ri.Header.DwType == co.RIM_TYPEMOUSE {
+//		println(ri.Mouse().LLastX, ri.Mouse().LLastY)
+//	}
+//
+// [GetRawInputData]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdata
+func (hRaw HRAWINPUT) GetRawInputData() (*RAWINPUT, error) {
+	szHeader := unsafe.Sizeof(RAWINPUTHEADER{})
+
+	var szData uint32
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetRawInputData, "GetRawInputData"),
+		uintptr(hRaw),
+		uintptr(co.RID_INPUT),
+		0,
+		uintptr(unsafe.Pointer(&szData)),
+		szHeader)
+	if int32(ret) == -1 {
+		return nil, co.ERROR(err)
+	}
+
+	if uintptr(szData) < unsafe.Sizeof(RAWINPUT{}) {
+		szData = uint32(unsafe.Sizeof(RAWINPUT{})) // keyboard data is smaller than the union
+	}
+	buf := make([]uint64, (szData+7)/8) // 8-byte aligned, to hold the handles
+	pRaw := (*RAWINPUT)(unsafe.Pointer(&buf[0]))
+
+	ret, _, err = syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetRawInputData, "GetRawInputData"),
+		uintptr(hRaw),
+		uintptr(co.RID_INPUT),
+		uintptr(unsafe.Pointer(pRaw)),
+		uintptr(unsafe.Pointer(&szData)),
+		szHeader)
+	if int32(ret) == -1 {
+		return nil, co.ERROR(err)
+	}
+	return pRaw, nil
+}
+
+var _GetRawInputData *syscall.Proc
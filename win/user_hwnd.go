@@ -91,6 +91,17 @@ func GetClipboardOwner() (HWND, error) {
 
 var _GetClipboardOwner *syscall.Proc
 
+// [GetCapture] function.
+//
+// [GetCapture]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getcapture
+func GetCapture() HWND {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetCapture, "GetCapture"))
+	return HWND(ret)
+}
+
+var _GetCapture *syscall.Proc
+
 // [GetDesktopWindow] function.
 //
 // [GetDesktopWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdesktopwindow
@@ -570,6 +581,20 @@ func (hWnd HWND) GetDlgItem(dlgId uint16) (HWND, error) {
 
 var _GetDlgItem *syscall.Proc
 
+// [GetDpiForWindow] function.
+//
+// Available on Windows 10 version 1607 and later.
+//
+// [GetDpiForWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdpiforwindow
+func (hWnd HWND) GetDpiForWindow() uint32 {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetDpiForWindow, "GetDpiForWindow"),
+		uintptr(hWnd))
+	return uint32(ret)
+}
+
+var _GetDpiForWindow *syscall.Proc
+
 // [GetLastActivePopup] function.
 //
 // [GetLastActivePopup]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getlastactivepopup
@@ -643,6 +668,31 @@ func (hWnd HWND) GetParent() (HWND, error) {
 
 var _GetParent *syscall.Proc
 
+// [GetScrollInfo] function.
+//
+// ⚠️ You must call [SCROLLINFO.SetCbSize] and set the FMask field.
+//
+// # Example
+//
+//	var hWnd win.HWND // initialized somewhere
+//
+//	var si win.SCROLLINFO
+//	si.SetCbSize()
+//	si.FMask = co.SIF_ALL
+//	_ = hWnd.GetScrollInfo(co.SB_TYPE_VERT, &si)
+//
+// [GetScrollInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getscrollinfo
+func (hWnd HWND) GetScrollInfo(bar co.SB_TYPE, si *SCROLLINFO) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetScrollInfo, "GetScrollInfo"),
+		uintptr(hWnd),
+		uintptr(bar),
+		uintptr(unsafe.Pointer(si)))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _GetScrollInfo *syscall.Proc
+
 // [GetTitleBarInfo] function.
 //
 // [GetTitleBarInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-gettitlebarinfo
@@ -906,6 +956,19 @@ func (hWnd HWND) IsWindow() bool {
 
 var _IsWindow *syscall.Proc
 
+// [KillTimer] function.
+//
+// [KillTimer]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-killtimer
+func (hWnd HWND) KillTimer(idEvent uintptr) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_KillTimer, "KillTimer"),
+		uintptr(hWnd),
+		idEvent)
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _KillTimer *syscall.Proc
+
 // [MapDialogRect] function.
 //
 // [MapDialogRect]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-mapdialogrect
@@ -985,6 +1048,23 @@ func (hWnd HWND) RedrawWindow(rcUpdate *RECT, hrgnUpdate HRGN, flags co.RDW) err
 
 var _RedrawWindow *syscall.Proc
 
+// [RegisterHotKey] function.
+//
+// ⚠️ You must defer [HWND.UnregisterHotKey].
+//
+// [RegisterHotKey]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerhotkey
+func (hWnd HWND) RegisterHotKey(id int32, modifiers co.MOD, vk co.VK) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_RegisterHotKey, "RegisterHotKey"),
+		uintptr(hWnd),
+		uintptr(id),
+		uintptr(modifiers),
+		uintptr(vk))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _RegisterHotKey *syscall.Proc
+
 // [ReleaseDC] function.
 //
 // [ReleaseDC]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-releasedc
@@ -1030,6 +1110,31 @@ func (hWnd HWND) ScreenToClientRc(rc *RECT) error {
 	return utl.ZeroAsSysInvalidParm(ret)
 }
 
+// [ScrollWindowEx] function.
+//
+// [ScrollWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-scrollwindowex
+func (hWnd HWND) ScrollWindowEx(
+	dx, dy int,
+	rcScroll, rcClip *RECT,
+	hrgnUpdate HRGN,
+	rcUpdate *RECT,
+	flags co.SCROLLW,
+) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_ScrollWindowEx, "ScrollWindowEx"),
+		uintptr(hWnd),
+		uintptr(dx),
+		uintptr(dy),
+		uintptr(unsafe.Pointer(rcScroll)),
+		uintptr(unsafe.Pointer(rcClip)),
+		uintptr(hrgnUpdate),
+		uintptr(unsafe.Pointer(rcUpdate)),
+		uintptr(flags))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _ScrollWindowEx *syscall.Proc
+
 // [SendMessage] function.
 //
 // [SendMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendmessagew
@@ -1048,6 +1153,21 @@ func (hWnd HWND) SendMessage(msg co.WM, wParam WPARAM, lParam LPARAM) (uintptr,
 
 var _SendMessageW *syscall.Proc
 
+// [SetCapture] function.
+//
+// Returns a handle to the window that had previously captured the mouse, if
+// any.
+//
+// [SetCapture]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setcapture
+func (hWnd HWND) SetCapture() HWND {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SetCapture, "SetCapture"),
+		uintptr(hWnd))
+	return HWND(ret)
+}
+
+var _SetCapture *syscall.Proc
+
 // [SetFocus] function.
 //
 // Returns a handle to the previously focused window.
@@ -1093,6 +1213,45 @@ func (hWnd HWND) SetMenu(hMenu HMENU) error {
 
 var _SetMenu *syscall.Proc
 
+// [SetScrollInfo] function.
+//
+// Returns the current position of the scroll box.
+//
+// [SetScrollInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setscrollinfo
+func (hWnd HWND) SetScrollInfo(bar co.SB_TYPE, si *SCROLLINFO, redraw bool) int {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SetScrollInfo, "SetScrollInfo"),
+		uintptr(hWnd),
+		uintptr(bar),
+		uintptr(unsafe.Pointer(si)),
+		utl.BoolToUintptr(redraw))
+	return int(int32(ret))
+}
+
+var _SetScrollInfo *syscall.Proc
+
+// [SetTimer] function.
+//
+// No timer procedure is used, so a WM_TIMER message will be posted to the
+// window when the timer elapses. If a timer with the same ID already exists,
+// it's replaced.
+//
+// [SetTimer]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-settimer
+func (hWnd HWND) SetTimer(idEvent uintptr, msElapse int) (uintptr, error) {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SetTimer, "SetTimer"),
+		uintptr(hWnd),
+		idEvent,
+		uintptr(uint32(msElapse)),
+		0)
+	if ret == 0 {
+		return 0, co.ERROR(err)
+	}
+	return ret, nil
+}
+
+var _SetTimer *syscall.Proc
+
 // [SetWindowDisplayAffinity] function.
 //
 // [SetWindowDisplayAffinity]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowdisplayaffinity
@@ -1194,6 +1353,20 @@ func (hWnd HWND) ShowOwnedPopups(show bool) error {
 
 var _ShowOwnedPopups *syscall.Proc
 
+// [ShowScrollBar] function.
+//
+// [ShowScrollBar]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-showscrollbar
+func (hWnd HWND) ShowScrollBar(bar co.SB_TYPE, show bool) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_ShowScrollBar, "ShowScrollBar"),
+		uintptr(hWnd),
+		uintptr(bar),
+		utl.BoolToUintptr(show))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _ShowScrollBar *syscall.Proc
+
 // [ShowWindow] function.
 //
 // [ShowWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-showwindow
@@ -1299,6 +1472,19 @@ func (hWnd HWND) TranslateAccelerator(hAccel HACCEL, msg *MSG) error {
 
 var _TranslateAcceleratorW *syscall.Proc
 
+// [UnregisterHotKey] function.
+//
+// [UnregisterHotKey]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-unregisterhotkey
+func (hWnd HWND) UnregisterHotKey(id int32) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_UnregisterHotKey, "UnregisterHotKey"),
+		uintptr(hWnd),
+		uintptr(id))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _UnregisterHotKey *syscall.Proc
+
 // [UpdateWindow] function.
 //
 // [UpdateWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-updatewindow
@@ -3,6 +3,7 @@
 package win
 
 import (
+	"encoding/binary"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/internal/utl"
@@ -129,6 +130,20 @@ func (dd *DISPLAY_DEVICE) SetDeviceString(val string) {
 	wstr.EncodeToBuf(val, dd.deviceString[:])
 }
 
+// [DLGITEMTEMPLATEEX] struct syntactic sugar, which describes a control of a
+// [DLGTEMPLATEEX]. The coordinates are given in dialog units.
+//
+// [DLGITEMTEMPLATEEX]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dlgitemtemplateex
+type DLGITEMTEMPLATEEX struct {
+	HelpId       uint32
+	ExStyle      co.WS_EX
+	Style        co.WS // Control styles, like co.BS or co.ES, combined with co.WS_CHILD.
+	X, Y, Cx, Cy int16
+	Id           uint32
+	ClassName    string // Like "Button", "Edit" or "SysListView32".
+	Title        string
+}
+
 // [DLGTEMPLATE] struct.
 //
 // [DLGTEMPLATE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-dlgtemplate
@@ -139,6 +154,134 @@ type DLGTEMPLATE struct {
 	X, Y, Cx, Cy    int16
 }
 
+// [DLGTEMPLATEEX] struct syntactic sugar, which describes a dialog box and its
+// controls, so it can be created without a resource. The coordinates are given
+// in dialog units.
+//
+// This struct has a variable length, so it must be serialized before being
+// passed to the functions.
+//
+// # Example
+//
+//	tmpl := win.DLGTEMPLATEEX{
+//		Style: co.WS_CAPTION | co.WS_SYSMENU | co.WS_POPUP |
+//			co.WS(co.DS_MODALFRAME|co.DS_CENTER),
+//		Cx: 180, Cy: 60,
+//		Title:     "Hello",
+//		PointSize: 9,
+//		FaceName:  "Segoe UI",
+//		Items: []win.DLGITEMTEMPLATEEX{
+//			{
+//				Style: co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP |
+//					co.WS(co.BS_DEFPUSHBUTTON),
+//				X: 65, Y: 35, Cx: 50, Cy: 14,
+//				Id:        uint32(co.ID_OK),
+//				ClassName: "Button",
+//				Title:     "&OK",
+//			},
+//		},
+//	}
+//	data := tmpl.Serialize()
+//
+// [DLGTEMPLATEEX]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dlgtemplateex
+type DLGTEMPLATEEX struct {
+	HelpId       uint32
+	ExStyle      co.WS_EX
+	Style        co.WS // Window styles combined with co.DS; co.DS_SETFONT is added if FaceName is set.
+	X, Y, Cx, Cy int16
+	Menu         ResId  // Optional menu resource.
+	ClassName    string // Optional window class; if empty, the predefined dialog class is used.
+	Title        string
+	PointSize    uint16
+	Weight       co.FW
+	Italic       bool
+	CharSet      co.CHARSET
+	FaceName     string // If empty, the system font is used, and the other font fields are ignored.
+	Items        []DLGITEMTEMPLATEEX
+}
+
+// Serializes the struct and its items into the binary format expected by
+// [HINSTANCE.CreateDialogIndirectParam] and
+// [HINSTANCE.DialogBoxIndirectParam].
+//
+// The returned slice must be kept alive while it's being used.
+func (dt *DLGTEMPLATEEX) Serialize() []byte {
+	style := dt.Style
+	if dt.FaceName != "" {
+		style |= co.WS(co.DS_SETFONT)
+	} else {
+		style &^= co.WS(co.DS_SETFONT | co.DS_SHELLFONT)
+	}
+
+	buf := make([]byte, 0, 256)                         // arbitrary
+	buf = binary.LittleEndian.AppendUint16(buf, 1)      // dlgVer
+	buf = binary.LittleEndian.AppendUint16(buf, 0xffff) // signature
+	buf = binary.LittleEndian.AppendUint32(buf, dt.HelpId)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(dt.ExStyle))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(style))
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(dt.Items)))
+	buf = dt.appendCoords(buf, dt.X, dt.Y, dt.Cx, dt.Cy)
+
+	if id, ok := dt.Menu.Int(); ok {
+		buf = dt.appendOrdinal(buf, id)
+	} else if str, ok := dt.Menu.Str(); ok {
+		buf = dt.appendSzOrNone(buf, str)
+	} else {
+		buf = binary.LittleEndian.AppendUint16(buf, 0) // no menu
+	}
+	buf = dt.appendSzOrNone(buf, dt.ClassName)
+	buf = dt.appendSz(buf, dt.Title)
+
+	if dt.FaceName != "" {
+		buf = binary.LittleEndian.AppendUint16(buf, dt.PointSize)
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(dt.Weight))
+		buf = append(buf, byte(utl.BoolToUint32(dt.Italic)), byte(dt.CharSet))
+		buf = dt.appendSz(buf, dt.FaceName)
+	}
+
+	for i := range dt.Items {
+		item := &dt.Items[i]
+		for len(buf)%4 != 0 { // each item is DWORD-aligned
+			buf = append(buf, 0)
+		}
+		buf = binary.LittleEndian.AppendUint32(buf, item.HelpId)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(item.ExStyle))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(item.Style))
+		buf = dt.appendCoords(buf, item.X, item.Y, item.Cx, item.Cy)
+		buf = binary.LittleEndian.AppendUint32(buf, item.Id)
+		buf = dt.appendSz(buf, item.ClassName)
+		buf = dt.appendSz(buf, item.Title)
+		buf = binary.LittleEndian.AppendUint16(buf, 0) // no creation data
+	}
+	return buf
+}
+
+func (*DLGTEMPLATEEX) appendCoords(buf []byte, x, y, cx, cy int16) []byte {
+	for _, v := range [...]int16{x, y, cx, cy} {
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(v))
+	}
+	return buf
+}
+
+func (*DLGTEMPLATEEX) appendOrdinal(buf []byte, id uint16) []byte {
+	buf = binary.LittleEndian.AppendUint16(buf, 0xffff)
+	return binary.LittleEndian.AppendUint16(buf, id)
+}
+
+func (*DLGTEMPLATEEX) appendSz(buf []byte, s string) []byte {
+	for _, ch := range wstr.EncodeToSlice(s) { // includes terminating null
+		buf = binary.LittleEndian.AppendUint16(buf, ch)
+	}
+	return buf
+}
+
+func (dt *DLGTEMPLATEEX) appendSzOrNone(buf []byte, s string) []byte {
+	if s == "" {
+		return binary.LittleEndian.AppendUint16(buf, 0) // none
+	}
+	return dt.appendSz(buf, s)
+}
+
 // [DRAWITEMSTRUCT] struct.
 //
 // [DRAWITEMSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-drawitemstruct
@@ -181,6 +324,15 @@ func (gti *GUITHREADINFO) SetCbSize() {
 	gti.cbSize = uint32(unsafe.Sizeof(*gti))
 }
 
+// [HARDWAREINPUT] struct.
+//
+// [HARDWAREINPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-hardwareinput
+type HARDWAREINPUT struct {
+	UMsg    co.WM
+	WParamL uint16
+	WParamH uint16
+}
+
 // [HELPINFO] struct.
 //
 // ⚠️ You must call [HELPINFO.SetCbSize] to initialize the struct.
@@ -257,6 +409,50 @@ func (iix *ICONINFOEX) SetSzResName(val string) {
 	wstr.EncodeToBuf(val, iix.szResName[:])
 }
 
+// Returns the MOUSEINPUT variation of the union.
+func (in *INPUT) Mouse() *MOUSEINPUT {
+	return (*MOUSEINPUT)(unsafe.Pointer(&in.union0))
+}
+
+// Returns the KEYBDINPUT variation of the union.
+func (in *INPUT) Keyboard() *KEYBDINPUT {
+	return (*KEYBDINPUT)(unsafe.Pointer(&in.union0))
+}
+
+// Returns the HARDWAREINPUT variation of the union.
+func (in *INPUT) Hardware() *HARDWAREINPUT {
+	return (*HARDWAREINPUT)(unsafe.Pointer(&in.union0))
+}
+
+// [KBDLLHOOKSTRUCT] struct.
+//
+// [KBDLLHOOKSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-kbdllhookstruct
+type KBDLLHOOKSTRUCT struct {
+	vkCode      uint32
+	ScanCode    uint32
+	Flags       co.LLKHF
+	Time        uint32
+	DwExtraInfo uintptr
+}
+
+func (kb *KBDLLHOOKSTRUCT) VkCode() co.VK {
+	return co.VK(kb.vkCode)
+}
+func (kb *KBDLLHOOKSTRUCT) SetVkCode(val co.VK) {
+	kb.vkCode = uint32(val)
+}
+
+// [KEYBDINPUT] struct.
+//
+// [KEYBDINPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-keybdinput
+type KEYBDINPUT struct {
+	WVk         co.VK
+	WScan       uint16
+	DwFlags     co.KEYEVENTF
+	Time        uint32
+	DwExtraInfo uintptr
+}
+
 // Second message [parameter].
 //
 // [parameter]: https://learn.microsoft.com/en-us/windows/win32/winprog/windows-data-types#lparam
@@ -375,6 +571,18 @@ type MINMAXINFO struct {
 	PtMaxTrackSize POINT
 }
 
+// [MOUSEINPUT] struct.
+//
+// [MOUSEINPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mouseinput
+type MOUSEINPUT struct {
+	Dx          int32
+	Dy          int32
+	MouseData   uint32
+	DwFlags     co.MOUSEEVENTF
+	Time        uint32
+	DwExtraInfo uintptr
+}
+
 // [MSG] struct.
 //
 // [MSG]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-msg
@@ -387,6 +595,17 @@ type MSG struct {
 	Pt     POINT
 }
 
+// [MSLLHOOKSTRUCT] struct.
+//
+// [MSLLHOOKSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-msllhookstruct
+type MSLLHOOKSTRUCT struct {
+	Pt          POINT
+	MouseData   uint32 // Wheel delta or X button in the high-order word.
+	Flags       co.LLMHF
+	Time        uint32
+	DwExtraInfo uintptr
+}
+
 // [NCCALCSIZE_PARAMS] struct.
 //
 // [NCCALCSIZE_PARAMS]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-nccalcsize_params
@@ -470,6 +689,113 @@ func (pbs *POWERBROADCAST_SETTING) Data(i int) *uint8 {
 	return &pbs.data[i]
 }
 
+// [RAWHID] struct.
+//
+// The reports follow the struct in memory, so it can only be used when
+// returned by [RAWINPUT.Hid].
+//
+// [RAWHID]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawhid
+type RAWHID struct {
+	DwSizeHid uint32
+	DwCount   uint32
+	bRawData  [1]uint8
+}
+
+// Returns the i-th report, which is DwSizeHid bytes long.
+func (rh *RAWHID) Report(i int) []byte {
+	if i < 0 || i >= int(rh.DwCount) {
+		panic("RAWHID report index out of bounds.")
+	}
+	all := unsafe.Slice(&rh.bRawData[0], int(rh.DwSizeHid)*int(rh.DwCount))
+	return all[i*int(rh.DwSizeHid) : (i+1)*int(rh.DwSizeHid)]
+}
+
+// [RAWINPUT] struct.
+//
+// Returned by [HRAWINPUT.GetRawInputData]; the Header.DwType field tells which
+// variation of the union is valid.
+//
+// [RAWINPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinput
+type RAWINPUT struct {
+	Header RAWINPUTHEADER
+	union0 RAWMOUSE
+}
+
+// Returns the RAWMOUSE variation of the union.
+func (ri *RAWINPUT) Mouse() *RAWMOUSE {
+	return &ri.union0
+}
+
+// Returns the RAWKEYBOARD variation of the union.
+func (ri *RAWINPUT) Keyboard() *RAWKEYBOARD {
+	return (*RAWKEYBOARD)(unsafe.Pointer(&ri.union0))
+}
+
+// Returns the RAWHID variation of the union.
+func (ri *RAWINPUT) Hid() *RAWHID {
+	return (*RAWHID)(unsafe.Pointer(&ri.union0))
+}
+
+// [RAWINPUTDEVICE] struct.
+//
+// [RAWINPUTDEVICE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputdevice
+type RAWINPUTDEVICE struct {
+	UsUsagePage uint16
+	UsUsage     uint16
+	DwFlags     co.RIDEV
+	HwndTarget  HWND
+}
+
+// [RAWINPUTDEVICELIST] struct.
+//
+// [RAWINPUTDEVICELIST]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputdevicelist
+type RAWINPUTDEVICELIST struct {
+	HDevice HANDLE
+	DwType  co.RIM_TYPE
+}
+
+// [RAWINPUTHEADER] struct.
+//
+// [RAWINPUTHEADER]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputheader
+type RAWINPUTHEADER struct {
+	DwType  co.RIM_TYPE
+	DwSize  uint32
+	HDevice HANDLE
+	WParam  WPARAM
+}
+
+// [RAWKEYBOARD] struct.
+//
+// [RAWKEYBOARD]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawkeyboard
+type RAWKEYBOARD struct {
+	MakeCode         uint16
+	Flags            co.RI_KEY
+	Reserved         uint16
+	VKey             co.VK
+	Message          co.WM
+	ExtraInformation uint32
+}
+
+// [RAWMOUSE] struct.
+//
+// [RAWMOUSE]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawmouse
+type RAWMOUSE struct {
+	UsFlags            co.MOUSE
+	_                  uint16 // padding before the union
+	UsButtonFlags      co.RI_MOUSE
+	UsButtonData       uint16
+	UlRawButtons       uint32
+	LLastX             int32
+	LLastY             int32
+	UlExtraInformation uint32
+}
+
+// Returns the wheel rotation, in multiples of WHEEL_DELTA, when UsButtonFlags
+// has co.RI_MOUSE_WHEEL or co.RI_MOUSE_HWHEEL.
+func (rm *RAWMOUSE) WheelDelta() int16 {
+	return int16(rm.UsButtonData)
+}
+
 // [RECT] struct.
 //
 // Basic rectangle structure, with left, top, right and bottom values.
@@ -479,6 +805,94 @@ type RECT struct {
 	Left, Top, Right, Bottom int32
 }
 
+// [RID_DEVICE_INFO] struct.
+//
+// ⚠️ You must call [RID_DEVICE_INFO.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var rdi win.RID_DEVICE_INFO
+//	rdi.SetCbSize()
+//
+// [RID_DEVICE_INFO]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rid_device_info
+type RID_DEVICE_INFO struct {
+	cbSize uint32
+	DwType co.RIM_TYPE
+	union0 RID_DEVICE_INFO_KEYBOARD
+}
+
+// 1st variation of the union of [RID_DEVICE_INFO] struct.
+type RID_DEVICE_INFO_MOUSE struct {
+	DwId                uint32
+	DwNumberOfButtons   uint32
+	DwSampleRate        uint32
+	FHasHorizontalWheel int32 // This is a BOOL value.
+}
+
+// 2nd variation of the union of [RID_DEVICE_INFO] struct.
+type RID_DEVICE_INFO_KEYBOARD struct {
+	DwType                 uint32
+	DwSubType              uint32
+	DwKeyboardMode         uint32
+	DwNumberOfFunctionKeys uint32
+	DwNumberOfIndicators   uint32
+	DwNumberOfKeysTotal    uint32
+}
+
+// 3rd variation of the union of [RID_DEVICE_INFO] struct.
+type RID_DEVICE_INFO_HID struct {
+	DwVendorId      uint32
+	DwProductId     uint32
+	DwVersionNumber uint32
+	UsUsagePage     uint16
+	UsUsage         uint16
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (rdi *RID_DEVICE_INFO) SetCbSize() {
+	rdi.cbSize = uint32(unsafe.Sizeof(*rdi))
+}
+
+// Returns the 1st variation of the union.
+func (rdi *RID_DEVICE_INFO) Mouse() *RID_DEVICE_INFO_MOUSE {
+	return (*RID_DEVICE_INFO_MOUSE)(unsafe.Pointer(&rdi.union0))
+}
+
+// Returns the 2nd variation of the union.
+func (rdi *RID_DEVICE_INFO) Keyboard() *RID_DEVICE_INFO_KEYBOARD {
+	return &rdi.union0
+}
+
+// Returns the 3rd variation of the union.
+func (rdi *RID_DEVICE_INFO) Hid() *RID_DEVICE_INFO_HID {
+	return (*RID_DEVICE_INFO_HID)(unsafe.Pointer(&rdi.union0))
+}
+
+// [SCROLLINFO] struct.
+//
+// ⚠️ You must call [SCROLLINFO.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var si win.SCROLLINFO
+//	si.SetCbSize()
+//
+// [SCROLLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-scrollinfo
+type SCROLLINFO struct {
+	cbSize    uint32
+	FMask     co.SIF
+	NMin      int32
+	NMax      int32
+	NPage     uint32
+	NPos      int32
+	NTrackPos int32
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (si *SCROLLINFO) SetCbSize() {
+	si.cbSize = uint32(unsafe.Sizeof(*si))
+}
+
 // [SIZE] struct.
 //
 // Basic area size structure, with cx and cy values.
@@ -0,0 +1,25 @@
+//go:build windows
+
+package win
+
+import (
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// [INPUT] struct.
+//
+// Since the union holds a pointer-sized field, its layout depends on the
+// architecture.
+//
+// # Example
+//
+//	var in win.INPUT
+//	in.Type = co.INPUT_KEYBOARD
+//	in.Keyboard().WVk = co.VK_RETURN
+//
+// [INPUT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input
+type INPUT struct {
+	Type   co.INPUT
+	_      uint32    // padding, the union is 8-byte aligned
+	union0 [4]uint64 // MOUSEINPUT is the largest, 32 bytes
+}
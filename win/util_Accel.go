@@ -0,0 +1,165 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Named keys accepted by [ParseAccel]. When a key has more than one name, the
+// first one is used as a fallback display text.
+var _accelKeyNames = []struct {
+	name string
+	vk   co.VK
+}{
+	{"Backspace", co.VK_BACK}, {"Back", co.VK_BACK},
+	{"Tab", co.VK_TAB},
+	{"Enter", co.VK_RETURN}, {"Return", co.VK_RETURN},
+	{"Pause", co.VK_PAUSE},
+	{"Esc", co.VK_ESCAPE}, {"Escape", co.VK_ESCAPE},
+	{"Space", co.VK_SPACE},
+	{"PgUp", co.VK_PRIOR}, {"PageUp", co.VK_PRIOR},
+	{"PgDn", co.VK_NEXT}, {"PageDown", co.VK_NEXT},
+	{"End", co.VK_END},
+	{"Home", co.VK_HOME},
+	{"Left", co.VK_LEFT},
+	{"Up", co.VK_UP},
+	{"Right", co.VK_RIGHT},
+	{"Down", co.VK_DOWN},
+	{"Ins", co.VK_INSERT}, {"Insert", co.VK_INSERT},
+	{"Del", co.VK_DELETE}, {"Delete", co.VK_DELETE},
+	{"Num0", co.VK_NUMPAD0}, {"Num1", co.VK_NUMPAD1}, {"Num2", co.VK_NUMPAD2},
+	{"Num3", co.VK_NUMPAD3}, {"Num4", co.VK_NUMPAD4}, {"Num5", co.VK_NUMPAD5},
+	{"Num6", co.VK_NUMPAD6}, {"Num7", co.VK_NUMPAD7}, {"Num8", co.VK_NUMPAD8},
+	{"Num9", co.VK_NUMPAD9},
+	{"Plus", co.VK_OEM_PLUS}, {"+", co.VK_OEM_PLUS},
+	{"Comma", co.VK_OEM_COMMA}, {",", co.VK_OEM_COMMA},
+	{"Minus", co.VK_OEM_MINUS}, {"-", co.VK_OEM_MINUS},
+	{"Period", co.VK_OEM_PERIOD}, {".", co.VK_OEM_PERIOD},
+}
+
+// Parses a keyboard shortcut like "Ctrl+Shift+S", "Alt+F4" or "Ctrl++" into an
+// [ACCEL] which sends the given command ID. Modifiers and key names are case
+// insensitive.
+//
+// # Example
+//
+//	accel, _ := win.ParseAccel("Ctrl+Shift+S", 1001)
+//	hAccel, _ := win.CreateAcceleratorTable([]win.ACCEL{accel})
+//	defer hAccel.DestroyAcceleratorTable()
+func ParseAccel(shortcut string, cmd uint16) (ACCEL, error) {
+	parts := strings.Split(shortcut, "+")
+	if strings.HasSuffix(shortcut, "++") { // "Ctrl++" means Ctrl and the plus key
+		parts = append(parts[:len(parts)-2], "+")
+	}
+
+	accel := ACCEL{FVirt: co.ACCELF_VIRTKEY, Cmd: cmd}
+	for _, mod := range parts[:len(parts)-1] {
+		switch strings.ToLower(strings.TrimSpace(mod)) {
+		case "ctrl", "control":
+			accel.FVirt |= co.ACCELF_CONTROL
+		case "shift":
+			accel.FVirt |= co.ACCELF_SHIFT
+		case "alt":
+			accel.FVirt |= co.ACCELF_ALT
+		default:
+			return ACCEL{}, fmt.Errorf("invalid modifier %q in shortcut %q", mod, shortcut)
+		}
+	}
+
+	name := strings.ToUpper(strings.TrimSpace(parts[len(parts)-1]))
+	switch {
+	case len(name) == 1 && (name[0] >= 'A' && name[0] <= 'Z' || name[0] >= '0' && name[0] <= '9'):
+		accel.Key = co.VK(name[0]) // VK_A to VK_Z, VK_0 to VK_9
+		return accel, nil
+	case len(name) >= 2 && name[0] == 'F':
+		if n, err := strconv.Atoi(name[1:]); err == nil && n >= 1 && n <= 24 {
+			accel.Key = co.VK_F1 + co.VK(n-1)
+			return accel, nil
+		}
+	}
+	for _, key := range _accelKeyNames {
+		if strings.EqualFold(key.name, name) {
+			accel.Key = key.vk
+			return accel, nil
+		}
+	}
+	return ACCEL{}, fmt.Errorf("invalid key in shortcut %q", shortcut)
+}
+
+// Returns the display text of the accelerator, like "Ctrl+Shift+S", with the
+// key names given by the current keyboard layout, with [GetKeyNameText].
+func (a *ACCEL) ShortcutText() string {
+	if (a.FVirt & co.ACCELF_VIRTKEY) == 0 { // Key is a character code
+		return string(rune(a.Key))
+	}
+
+	var buf strings.Builder
+	if (a.FVirt & co.ACCELF_CONTROL) != 0 {
+		buf.WriteString(accelKeyName(co.VK_CONTROL, "Ctrl"))
+		buf.WriteByte('+')
+	}
+	if (a.FVirt & co.ACCELF_ALT) != 0 {
+		buf.WriteString(accelKeyName(co.VK_MENU, "Alt"))
+		buf.WriteByte('+')
+	}
+	if (a.FVirt & co.ACCELF_SHIFT) != 0 {
+		buf.WriteString(accelKeyName(co.VK_SHIFT, "Shift"))
+		buf.WriteByte('+')
+	}
+
+	var fallback string
+	switch {
+	case a.Key >= co.VK_0 && a.Key <= co.VK_9, a.Key >= co.VK_A && a.Key <= co.VK_Z:
+		fallback = string(rune(a.Key))
+	case a.Key >= co.VK_F1 && a.Key <= co.VK_F24:
+		fallback = fmt.Sprintf("F%d", a.Key-co.VK_F1+1)
+	default:
+		for _, key := range _accelKeyNames {
+			if key.vk == a.Key {
+				fallback = key.name
+				break
+			}
+		}
+		if fallback == "" {
+			fallback = fmt.Sprintf("0x%02x", uint16(a.Key))
+		}
+	}
+	buf.WriteString(accelKeyName(a.Key, fallback))
+	return buf.String()
+}
+
+// Retrieves the name of the virtual key in the current keyboard layout, or
+// returns the fallback if it has none.
+func accelKeyName(vk co.VK, fallback string) string {
+	scanCode := MapVirtualKey(uint32(vk), co.MAPVK_VK_TO_VSC)
+	if scanCode == 0 {
+		return fallback
+	}
+
+	lParam := int32(scanCode << 16)
+	if isExtendedKey(vk) {
+		lParam |= 1 << 24 // otherwise we get the numpad names
+	}
+
+	if name, err := GetKeyNameText(lParam); err == nil && name != "" {
+		return name
+	}
+	return fallback
+}
+
+// Tells whether the virtual key is an extended key, which shares its scan code
+// with another key, like the arrows and the numpad.
+func isExtendedKey(vk co.VK) bool {
+	switch vk {
+	case co.VK_PRIOR, co.VK_NEXT, co.VK_END, co.VK_HOME,
+		co.VK_LEFT, co.VK_UP, co.VK_RIGHT, co.VK_DOWN,
+		co.VK_INSERT, co.VK_DELETE, co.VK_DIVIDE, co.VK_NUMLOCK:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"unicode/utf16"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Types the given text with [SendInput], as if typed on the keyboard by the
+// user. Each character is sent with co.KEYEVENTF_UNICODE, so it doesn't
+// depend on the current keyboard layout; line breaks are sent as the Enter
+// key.
+//
+// # Example
+//
+//	win.SendInputText("Hello, world!\n")
+func SendInputText(text string) error {
+	inputs := make([]INPUT, 0, len(text)*2)
+	for _, ch := range utf16.Encode([]rune(text)) {
+		switch ch {
+		case '\r':
+			continue // "\r\n" is a single line break
+		case '\n':
+			inputs = append(inputs, keyInput(co.VK_RETURN, false), keyInput(co.VK_RETURN, true))
+		default:
+			for _, flags := range []co.KEYEVENTF{0, co.KEYEVENTF_KEYUP} {
+				var in INPUT
+				in.Type = co.INPUT_KEYBOARD
+				ki := in.Keyboard()
+				ki.WScan = ch
+				ki.DwFlags = co.KEYEVENTF_UNICODE | flags
+				inputs = append(inputs, in)
+			}
+		}
+	}
+	return sendAllInputs(inputs)
+}
+
+// Presses and releases the keyboard shortcut with [SendInput]. The shortcut
+// has the same syntax of [ParseAccel], like "Ctrl+Shift+S" or "Alt+F4".
+//
+// # Example
+//
+//	win.SendInputShortcut("Ctrl+A")
+//	win.SendInputShortcut("Ctrl+C")
+func SendInputShortcut(shortcut string) error {
+	accel, err := ParseAccel(shortcut, 0)
+	if err != nil {
+		return err
+	}
+
+	mods := make([]co.VK, 0, 3)
+	if (accel.FVirt & co.ACCELF_CONTROL) != 0 {
+		mods = append(mods, co.VK_CONTROL)
+	}
+	if (accel.FVirt & co.ACCELF_ALT) != 0 {
+		mods = append(mods, co.VK_MENU)
+	}
+	if (accel.FVirt & co.ACCELF_SHIFT) != 0 {
+		mods = append(mods, co.VK_SHIFT)
+	}
+
+	inputs := make([]INPUT, 0, len(mods)*2+2)
+	for _, mod := range mods {
+		inputs = append(inputs, keyInput(mod, false))
+	}
+	inputs = append(inputs, keyInput(accel.Key, false), keyInput(accel.Key, true))
+	for i := len(mods) - 1; i >= 0; i-- { // release in reverse order
+		inputs = append(inputs, keyInput(mods[i], true))
+	}
+	return sendAllInputs(inputs)
+}
+
+// Moves the mouse cursor to the given point with [SendInput]. The coordinates
+// are in pixels, relative to the virtual desktop, which spans all monitors;
+// they are the same ones returned by [GetCursorPos].
+func SendInputMouseMove(x, y int) error {
+	return sendAllInputs([]INPUT{mouseMoveInput(x, y)})
+}
+
+// Moves the mouse cursor to the given point, then presses and releases the
+// button with [SendInput]. The coordinates are the same of
+// [SendInputMouseMove].
+//
+// The button must be co.VK_LBUTTON, co.VK_RBUTTON, co.VK_MBUTTON,
+// co.VK_XBUTTON1 or co.VK_XBUTTON2, otherwise panics.
+//
+// # Example
+//
+//	win.SendInputMouseClick(100, 200, co.VK_LBUTTON)
+func SendInputMouseClick(x, y int, button co.VK) error {
+	var down, up co.MOUSEEVENTF
+	var data uint32
+
+	switch button {
+	case co.VK_LBUTTON:
+		down, up = co.MOUSEEVENTF_LEFTDOWN, co.MOUSEEVENTF_LEFTUP
+	case co.VK_RBUTTON:
+		down, up = co.MOUSEEVENTF_RIGHTDOWN, co.MOUSEEVENTF_RIGHTUP
+	case co.VK_MBUTTON:
+		down, up = co.MOUSEEVENTF_MIDDLEDOWN, co.MOUSEEVENTF_MIDDLEUP
+	case co.VK_XBUTTON1, co.VK_XBUTTON2:
+		down, up = co.MOUSEEVENTF_XDOWN, co.MOUSEEVENTF_XUP
+		data = uint32(button-co.VK_XBUTTON1) + 1 // XBUTTON1 or XBUTTON2
+	default:
+		panic(fmt.Sprintf("Invalid mouse button: 0x%02x.", uint16(button)))
+	}
+
+	inputs := []INPUT{mouseMoveInput(x, y), mouseMoveInput(x, y), mouseMoveInput(x, y)}
+	for i, flags := range []co.MOUSEEVENTF{down, up} {
+		mi := inputs[i+1].Mouse()
+		mi.DwFlags |= flags
+		mi.MouseData = data
+	}
+	return sendAllInputs(inputs)
+}
+
+// Returns the INPUT to press or release the virtual key.
+func keyInput(vk co.VK, keyUp bool) INPUT {
+	var in INPUT
+	in.Type = co.INPUT_KEYBOARD
+	ki := in.Keyboard()
+	ki.WVk = vk
+	ki.WScan = uint16(MapVirtualKey(uint32(vk), co.MAPVK_VK_TO_VSC))
+	if isExtendedKey(vk) {
+		ki.DwFlags |= co.KEYEVENTF_EXTENDEDKEY
+	}
+	if keyUp {
+		ki.DwFlags |= co.KEYEVENTF_KEYUP
+	}
+	return in
+}
+
+// Returns the INPUT to move the mouse to the point in the virtual desktop,
+// whose coordinates are normalized to the 0-65535 range.
+func mouseMoveInput(x, y int) INPUT {
+	normalize := func(pos int, smOrigin, smSize co.SM) int32 {
+		origin, size := int64(GetSystemMetrics(smOrigin)), int64(GetSystemMetrics(smSize))
+		if size <= 1 {
+			return 0
+		}
+		norm := ((int64(pos)-origin)*65536 + size - 1) / size // rounded up, so it maps back to the same pixel
+		if norm < 0 {
+			norm = 0
+		} else if norm > 65535 {
+			norm = 65535
+		}
+		return int32(norm)
+	}
+
+	var in INPUT
+	in.Type = co.INPUT_MOUSE
+	mi := in.Mouse()
+	mi.Dx = normalize(x, co.SM_XVIRTUALSCREEN, co.SM_CXVIRTUALSCREEN)
+	mi.Dy = normalize(y, co.SM_YVIRTUALSCREEN, co.SM_CYVIRTUALSCREEN)
+	mi.DwFlags = co.MOUSEEVENTF_MOVE | co.MOUSEEVENTF_ABSOLUTE | co.MOUSEEVENTF_VIRTUALDESK
+	return in
+}
+
+// Calls SendInput, failing if not all events were inserted.
+func sendAllInputs(inputs []INPUT) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+	numSent, err := SendInput(inputs)
+	if err != nil {
+		return err
+	} else if numSent != uint(len(inputs)) {
+		return fmt.Errorf("SendInput inserted %d of %d events", numSent, len(inputs))
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"syscall"
 
 	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/internal/utl"
 	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/wstr"
 )
@@ -43,3 +44,25 @@ func (hWnd HWND) OpenThemeData(classNames string) (HTHEME, error) {
 }
 
 var _OpenThemeData *syscall.Proc
+
+// [SetWindowTheme] function.
+//
+// Empty strings are passed as NULL, which restores the default theme. To
+// disable the visual styles of the window, pass a single space.
+//
+// [SetWindowTheme]: https://learn.microsoft.com/en-us/windows/win32/api/uxtheme/nf-uxtheme-setwindowtheme
+func (hWnd HWND) SetWindowTheme(subAppName, subIdList string) error {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+	pSubAppName := wbuf.PtrEmptyIsNil(subAppName)
+	pSubIdList := wbuf.PtrEmptyIsNil(subIdList)
+
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.UXTHEME, &_SetWindowTheme, "SetWindowTheme"),
+		uintptr(hWnd),
+		uintptr(pSubAppName),
+		uintptr(pSubIdList))
+	return utl.ErrorAsHResult(ret)
+}
+
+var _SetWindowTheme *syscall.Proc